@@ -1,6 +1,11 @@
 package analysis
 
-import "github.com/j-clemons/dbt-language-server/lsp"
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/j-clemons/dbt-language-server/lsp"
+)
 
 type ModelDetails struct {
 	URI         string
@@ -8,6 +13,23 @@ type ModelDetails struct {
 	Description string
 	SchemaURI   string
 	SchemaRange lsp.Range
+	Columns     []Column
+	// InferredColumns are read from the model SQL, or a seed's header, when
+	// every output column can be named. Catalog Columns take precedence.
+	InferredColumns []Column
+	// Range is the name of a snapshot within URI, which may define several.
+	Range    lsp.Range
+	Snapshot bool
+	// Version is set for versioned models. The entry under the model's name
+	// is its latest version, and Versions holds every version by `v`.
+	Version  string
+	Versions map[string]ModelDetails
+	// Access, Group and DeprecationDate are the model's governance settings
+	// from dbt_project.yml, properties files and SQL config, or the manifest.
+	// An empty Access is dbt's default, protected.
+	Access          string
+	Group           string
+	DeprecationDate string
 }
 
 type ProjectDetails struct {
@@ -15,8 +37,10 @@ type ProjectDetails struct {
 	DbtProjectYaml DbtProjectYaml
 }
 
-func (s *State) getModelDetails() (map[string]ModelDetails, map[string]Source) {
-	modelMap := make(map[string]ModelDetails)
+// getModelDetails indexes the models, seeds and snapshots of the project and
+// each package, keyed by package and then name.
+func (s *State) getModelDetails() (map[Package]map[string]ModelDetails, map[string]Source) {
+	packageModelMap := make(map[Package]map[string]ModelDetails)
 	sourceMap := make(map[string]Source)
 
 	packageDetails := getPackageModelDetails(s.DbtContext.ProjectRoot, s.DbtContext.ProjectYaml)
@@ -30,6 +54,7 @@ func (s *State) getModelDetails() (map[string]ModelDetails, map[string]Source) {
 	processList = append(processList, packageDetails...)
 
 	for _, p := range processList {
+		modelMap := make(map[string]ModelDetails)
 		modelPathMap := createModelPathMap(p.RootPath, p.DbtProjectYaml)
 		modelSchemaDetails, projectSourceMap := parseYamlModels(p.RootPath, p.DbtProjectYaml)
 
@@ -59,18 +84,37 @@ func (s *State) getModelDetails() (map[string]ModelDetails, map[string]Source) {
 			}
 
 			modelMap[modelMapKey] = ModelDetails{
-				URI:         v,
-				ProjectName: p.DbtProjectYaml.ProjectName.Value,
-				Description: description,
-				SchemaURI:   schemaURI,
-				SchemaRange: schemaRange,
+				URI:             v,
+				ProjectName:     p.DbtProjectYaml.ProjectName.Value,
+				Description:     description,
+				SchemaURI:       schemaURI,
+				SchemaRange:     schemaRange,
+				Access:          schemaDetails.Access.Value,
+				Group:           schemaDetails.Group.Value,
+				DeprecationDate: schemaDetails.DeprecationDate.Value,
 			}
 		}
+		foldModelVersions(modelMap, modelSchemaDetails)
+		s.applyModelGovernance(modelMap, p)
+		if p.DbtProjectYaml.RestrictAccess.Value {
+			restrictAccess(modelMap)
+		}
+
+		for k, v := range createSnapshotMap(p.RootPath, p.DbtProjectYaml) {
+			v.ProjectName = p.DbtProjectYaml.ProjectName.Value
+			modelMap[k] = v
+		}
+
+		packageModelMap[Package(p.DbtProjectYaml.ProjectName.Value)] = modelMap
 	}
 
+	projectName := Package(s.DbtContext.ProjectYaml.ProjectName.Value)
+	if packageModelMap[projectName] == nil {
+		packageModelMap[projectName] = make(map[string]ModelDetails)
+	}
 	seedPathMap := createSeedPathMap(s.DbtContext.ProjectRoot, s.DbtContext.ProjectYaml)
 	for k, v := range seedPathMap {
-		modelMap[k] = ModelDetails{
+		packageModelMap[projectName][k] = ModelDetails{
 			URI:         v,
 			ProjectName: s.DbtContext.ProjectYaml.ProjectName.Value,
 			Description: "Seed File",
@@ -78,5 +122,156 @@ func (s *State) getModelDetails() (map[string]ModelDetails, map[string]Source) {
 			SchemaRange: lsp.Range{},
 		}
 	}
-	return modelMap, sourceMap
+	return packageModelMap, sourceMap
+}
+
+// modelsByName flattens the package model map into the map ref('name')
+// resolves against. A model in the root project takes precedence over a
+// package model with the same name.
+func modelsByName(packageModelMap map[Package]map[string]ModelDetails, projectName string) map[string]ModelDetails {
+	modelMap := make(map[string]ModelDetails)
+	for pkg, models := range packageModelMap {
+		if string(pkg) == projectName {
+			continue
+		}
+		for name, model := range models {
+			modelMap[name] = model
+		}
+	}
+	for name, model := range packageModelMap[Package(projectName)] {
+		modelMap[name] = model
+	}
+	return modelMap
+}
+
+// foldModelVersions replaces the files of each versioned model, such as
+// dim_customers_v1.sql and dim_customers_v2.sql, with one entry under the
+// model's name that resolves to its latest version.
+func foldModelVersions(modelMap map[string]ModelDetails, properties map[string]ModelProperties) {
+	for name, props := range properties {
+		if len(props.Versions) == 0 {
+			continue
+		}
+
+		versions := make(map[string]ModelDetails)
+		latest := ""
+		for _, v := range props.Versions {
+			if v.V.Value == nil {
+				continue
+			}
+			version := fmt.Sprint(v.V.Value)
+			file := v.DefinedIn.Value
+			if file == "" {
+				file = fmt.Sprintf("%s_v%s", name, version)
+			}
+			model, ok := modelMap[file]
+			if !ok {
+				continue
+			}
+			delete(modelMap, file)
+
+			model.Version = version
+			model.Description = props.Description.Value
+			if v.Description.Value != "" {
+				model.Description = v.Description.Value
+			}
+			model.SchemaURI = props.SchemaURI
+			model.SchemaRange = lsp.Range{Start: props.Name.Position, End: props.Name.Position}
+			model.Access = props.Access.Value
+			model.Group = props.Group.Value
+			model.DeprecationDate = props.DeprecationDate.Value
+			if v.DeprecationDate.Value != "" {
+				model.DeprecationDate = v.DeprecationDate.Value
+			}
+			versions[version] = model
+
+			if latest == "" || compareVersions(version, latest) > 0 {
+				latest = version
+			}
+		}
+		if props.LatestVersion.Value != nil {
+			latest = fmt.Sprint(props.LatestVersion.Value)
+		}
+
+		model, ok := versions[latest]
+		if !ok {
+			continue
+		}
+		model.Versions = versions
+		modelMap[name] = model
+	}
+}
+
+// restrictAccess makes models without an explicit access protected, as dbt
+// does for projects with restrict-access set.
+func restrictAccess(modelMap map[string]ModelDetails) {
+	for name, model := range modelMap {
+		if model.Access == "" {
+			model.Access = "protected"
+		}
+		for v, version := range model.Versions {
+			if version.Access == "" {
+				version.Access = "protected"
+				model.Versions[v] = version
+			}
+		}
+		modelMap[name] = model
+	}
+}
+
+// compareVersions orders model versions numerically when both are numbers.
+func compareVersions(a string, b string) int {
+	af, aErr := strconv.ParseFloat(a, 64)
+	bf, bErr := strconv.ParseFloat(b, 64)
+	switch {
+	case aErr == nil && bErr == nil && af < bf:
+		return -1
+	case aErr == nil && bErr == nil && af > bf:
+		return 1
+	case aErr == nil && bErr == nil:
+		return 0
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// resolveModel finds the model a ref() points at, following its package
+// and version arguments when they are given.
+func (s *State) resolveModel(key ReferenceKey) (ModelDetails, bool) {
+	model, ok := s.DbtContext.ModelDetailMap[key.Name]
+	if key.Package != "" && (!ok || model.ProjectName != key.Package) {
+		model, ok = s.DbtContext.PackageModelMap[Package(key.Package)][key.Name]
+	}
+	if !ok {
+		return ModelDetails{}, false
+	}
+	if key.Version != "" && key.Version != model.Version {
+		version, ok := model.Versions[key.Version]
+		return version, ok
+	}
+	return model, true
+}
+
+// sameModel reports whether a and b are the same model, or model version.
+func sameModel(a ModelDetails, b ModelDetails) bool {
+	return a.URI == b.URI && a.ProjectName == b.ProjectName && a.Version == b.Version && a.Range == b.Range
+}
+
+// modelHoverDescription notes which version of a versioned model a ref
+// resolves to.
+func modelHoverDescription(model ModelDetails) string {
+	if model.Version == "" {
+		return model.Description
+	}
+	version := "Version: " + model.Version
+	if len(model.Versions) > 0 {
+		version += " (latest)"
+	}
+	if model.Description == "" {
+		return version
+	}
+	return model.Description + "\n\n" + version
 }
@@ -0,0 +1,189 @@
+package analysis
+
+import (
+	"context"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/j-clemons/dbt-language-server/analysis/parser"
+	"github.com/j-clemons/dbt-language-server/lsp"
+	"github.com/j-clemons/dbt-language-server/testutils"
+)
+
+func TestVersionedAndPackageModels(t *testing.T) {
+	root, err := testutils.GetTestdataPath("versioned_project")
+	if err != nil {
+		t.Fatal(err)
+	}
+	state := NewState()
+	state.refreshDbtContext(root)
+
+	names := []string{}
+	for name := range state.DbtContext.ModelDetailMap {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	if expected := []string{"dim_customers", "extra", "orders"}; !reflect.DeepEqual(names, expected) {
+		t.Fatalf("expected models %v, got %v", expected, names)
+	}
+
+	// the root project's orders wins over the package's
+	if uri := state.DbtContext.ModelDetailMap["orders"].URI; uri != filepath.Join(root, "models/orders.sql") {
+		t.Errorf("expected the project's orders model, got %s", uri)
+	}
+
+	tests := []struct {
+		name     string
+		key      ReferenceKey
+		expected string
+		found    bool
+	}{
+		{"latest version", ReferenceKey{Name: "dim_customers"}, "models/dim_customers_v2.sql", true},
+		{"pinned version", ReferenceKey{Name: "dim_customers", Version: "1"}, "models/dim_customers_v1.sql", true},
+		{"defined_in", ReferenceKey{Name: "dim_customers", Version: "3"}, "models/customers_next.sql", true},
+		{"missing version", ReferenceKey{Name: "dim_customers", Version: "4"}, "", false},
+		{"package model", ReferenceKey{Package: "shared", Name: "orders"}, "dbt_packages/shared/models/orders.sql", true},
+		{"project package", ReferenceKey{Package: "demo", Name: "orders"}, "models/orders.sql", true},
+		{"missing package", ReferenceKey{Package: "other", Name: "orders"}, "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.key.Type = parser.REF
+			model, found := state.resolveModel(tt.key)
+			if found != tt.found {
+				t.Fatalf("expected found=%v, got %v", tt.found, found)
+			}
+			if found && model.URI != filepath.Join(root, tt.expected) {
+				t.Errorf("expected %s, got %s", tt.expected, model.URI)
+			}
+		})
+	}
+
+	if v1, _ := state.resolveModel(ReferenceKey{Name: "dim_customers", Version: "1"}); v1.Description != "Original grain" {
+		t.Errorf("expected the version description, got %q", v1.Description)
+	}
+
+	uri := "file://" + filepath.Join(root, "models/report.sql")
+	state.parseDocument(uri, `select *
+from {{ ref('dim_customers', v=1) }}
+join {{ ref('shared', 'orders') }}
+join {{ ref('dim_customers') }}
+join {{ ref('dim_customers', version=9) }}
+join {{ ref('shared', 'missing') }}
+`)
+
+	definition := state.Definition(1, uri, lsp.Position{Line: 1, Character: 15}).Result
+	if definition.URI != "file://"+filepath.Join(root, "models/dim_customers_v1.sql") {
+		t.Errorf("expected definition in dim_customers_v1.sql, got %v", definition)
+	}
+	definition = state.Definition(2, uri, lsp.Position{Line: 2, Character: 25}).Result
+	if definition.URI != "file://"+filepath.Join(root, "dbt_packages/shared/models/orders.sql") {
+		t.Errorf("expected definition in the package's orders.sql, got %v", definition)
+	}
+
+	hover := state.Hover(3, uri, lsp.Position{Line: 3, Character: 15}).Result.Contents
+	if hover != "Customer dimension\n\nVersion: 2 (latest)" {
+		t.Errorf("unexpected hover %q", hover)
+	}
+
+	messages := []string{}
+	for _, d := range state.Diagnostics(uri) {
+		if d.Code == "unresolved-ref" {
+			messages = append(messages, d.Message)
+		}
+	}
+	expectedMessages := []string{
+		"Version '9' of model 'dim_customers' was not found",
+		"Model 'missing' was not found in package 'shared'",
+	}
+	if !reflect.DeepEqual(messages, expectedMessages) {
+		t.Errorf("expected %v, got %v", expectedMessages, messages)
+	}
+
+	// refs are told apart by the model, or model version, they resolve to
+	referenceTests := []struct {
+		position lsp.Position
+		lines    []int
+	}{
+		{lsp.Position{Line: 1, Character: 15}, []int{1}},
+		{lsp.Position{Line: 2, Character: 25}, []int{2}},
+		{lsp.Position{Line: 3, Character: 15}, []int{3}},
+	}
+	for _, tt := range referenceTests {
+		lines := []int{}
+		for _, r := range state.References(context.Background(), 4, uri, tt.position, false).Result {
+			if r.URI == uri {
+				lines = append(lines, r.Range.Start.Line)
+			}
+		}
+		if !reflect.DeepEqual(lines, tt.lines) {
+			t.Errorf("%v: expected references on lines %v of report.sql, got %v", tt.position, tt.lines, lines)
+		}
+	}
+	items := state.PrepareCallHierarchy(5, uri, lsp.Position{Line: 2, Character: 25}).Result
+	if len(items) != 1 || items[0].URI != "file://"+filepath.Join(root, "dbt_packages/shared/models/orders.sql") {
+		t.Fatalf("expected the package's orders, got %v", items)
+	}
+	calls := state.IncomingCalls(context.Background(), 6, items[0]).Result
+	if len(calls) != 1 || calls[0].From.URI != uri || len(calls[0].FromRanges) != 1 || calls[0].FromRanges[0].Start.Line != 2 {
+		t.Errorf("expected the package ref in report.sql, got %v", calls)
+	}
+
+	// outgoing calls are read from the file the item resolves to
+	outgoingTests := []struct {
+		position lsp.Position
+		expected []string
+	}{
+		{lsp.Position{Line: 1, Character: 15}, []string{"file://" + filepath.Join(root, "models/orders.sql")}},
+		{lsp.Position{Line: 2, Character: 25}, []string{}},
+		{lsp.Position{Line: 3, Character: 15}, []string{}},
+	}
+	for _, tt := range outgoingTests {
+		items := state.PrepareCallHierarchy(7, uri, tt.position).Result
+		if len(items) != 1 {
+			t.Fatalf("%v: expected one item, got %v", tt.position, items)
+		}
+		targets := []string{}
+		for _, call := range state.OutgoingCalls(8, items[0]).Result {
+			targets = append(targets, call.To.URI)
+		}
+		if !reflect.DeepEqual(targets, tt.expected) {
+			t.Errorf("%v: expected calls to %v, got %v", tt.position, tt.expected, targets)
+		}
+	}
+}
+
+func TestRefArgumentCompletion(t *testing.T) {
+	root, err := testutils.GetTestdataPath("versioned_project")
+	if err != nil {
+		t.Fatal(err)
+	}
+	state := NewState()
+	state.refreshDbtContext(root)
+
+	uri := "file://" + filepath.Join(root, "models/report.sql")
+	state.parseDocument(uri, "select * from {{ ref('shared', '\nselect * from {{ ref('dim_customers', v=\n")
+
+	labels := completionLabels(state.TextDocumentCompletion(1, uri, lsp.Position{Line: 0, Character: 32}).Result)
+	sort.Strings(labels)
+	if expected := []string{"extra", "orders"}; !reflect.DeepEqual(labels, expected) {
+		t.Errorf("expected package models %v, got %v", expected, labels)
+	}
+
+	items := state.TextDocumentCompletion(2, uri, lsp.Position{Line: 1, Character: 40}).Result
+	sort.Slice(items, func(i, j int) bool { return items[i].Label < items[j].Label })
+	details := []string{}
+	for _, item := range items {
+		details = append(details, item.Label+": "+item.Detail)
+	}
+	expected := []string{
+		"1: dim_customers_v1.sql",
+		"2: dim_customers_v2.sql (latest)",
+		"3: customers_next.sql",
+	}
+	if !reflect.DeepEqual(details, expected) {
+		t.Errorf("expected versions %v, got %v", expected, details)
+	}
+}
@@ -0,0 +1,143 @@
+package analysis
+
+import "regexp"
+
+// callContext describes the function call enclosing a cursor.
+type callContext struct {
+	Package         string
+	Name            string
+	ActiveParameter int
+	// ArgumentText is the text of the argument being typed, up to the cursor.
+	ArgumentText string
+	InJinja      bool
+}
+
+type openBracket struct {
+	char     byte
+	index    int
+	commas   int
+	argStart int
+	jinja    bool
+}
+
+var keywordArgRegex = regexp.MustCompile(`^\s*(\w+)\s*=($|[^=])`)
+
+// KeywordArgument returns the name of the keyword argument being typed, e.g.
+// "quote" for `my_macro(col, quote=`.
+func (c callContext) KeywordArgument() (string, bool) {
+	match := keywordArgRegex.FindStringSubmatch(c.ArgumentText)
+	if match == nil {
+		return "", false
+	}
+	return match[1], true
+}
+
+func isIdentByte(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
+
+// identBefore returns the identifier ending right before text[end], skipping
+// whitespace, along with its start index.
+func identBefore(text string, end int) (string, int) {
+	i := end
+	for i > 0 && (text[i-1] == ' ' || text[i-1] == '\t') {
+		i--
+	}
+	stop := i
+	for i > 0 && isIdentByte(text[i-1]) {
+		i--
+	}
+	return text[i:stop], i
+}
+
+// findCallContext scans text up to offset and returns the innermost
+// unclosed call. Strings, SQL line comments and jinja comments are skipped
+// and brackets opened inside a jinja tag are dropped when the tag closes.
+func findCallContext(text string, offset int) (callContext, bool) {
+	if offset > len(text) {
+		offset = len(text)
+	}
+
+	stack := []openBracket{}
+	inJinja := false
+	inJinjaComment := false
+	inLineComment := false
+	var quote byte
+
+	for i := 0; i < offset; i++ {
+		c := text[i]
+		next := byte(0)
+		if i+1 < len(text) {
+			next = text[i+1]
+		}
+
+		switch {
+		case quote != 0:
+			if c == '\\' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case inLineComment:
+			if c == '\n' {
+				inLineComment = false
+			}
+		case inJinjaComment:
+			if c == '#' && next == '}' {
+				inJinjaComment = false
+				i++
+			}
+		case c == '{' && next == '#':
+			inJinjaComment = true
+			i++
+		case c == '{' && (next == '{' || next == '%'):
+			inJinja = true
+			i++
+		case inJinja && (c == '}' || c == '%') && next == '}':
+			inJinja = false
+			for len(stack) > 0 && stack[len(stack)-1].jinja {
+				stack = stack[:len(stack)-1]
+			}
+			i++
+		case !inJinja && c == '-' && next == '-':
+			inLineComment = true
+		case c == '\'' || c == '"':
+			quote = c
+		case c == '(' || c == '[' || c == '{':
+			stack = append(stack, openBracket{char: c, index: i, argStart: i + 1, jinja: inJinja})
+		case c == ')' || c == ']' || c == '}':
+			if len(stack) > 0 {
+				stack = stack[:len(stack)-1]
+			}
+		case c == ',':
+			if len(stack) > 0 {
+				stack[len(stack)-1].commas++
+				stack[len(stack)-1].argStart = i + 1
+			}
+		}
+	}
+
+	for k := len(stack) - 1; k >= 0; k-- {
+		frame := stack[k]
+		if frame.char != '(' {
+			continue
+		}
+
+		name, nameStart := identBefore(text, frame.index)
+		if name == "" {
+			return callContext{}, false
+		}
+		ctx := callContext{
+			Name:            name,
+			ActiveParameter: frame.commas,
+			ArgumentText:    text[frame.argStart:offset],
+			InJinja:         frame.jinja,
+		}
+		if nameStart > 0 && text[nameStart-1] == '.' {
+			ctx.Package, _ = identBefore(text, nameStart-1)
+		}
+		return ctx, true
+	}
+
+	return callContext{}, false
+}
@@ -0,0 +1,69 @@
+package analysis
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFindCallContext(t *testing.T) {
+	testCases := []struct {
+		name     string
+		text     string
+		expected callContext
+		found    bool
+	}{
+		{
+			name:     "first argument",
+			text:     "select {{ full_name(|",
+			expected: callContext{Name: "full_name", ActiveParameter: 0, InJinja: true},
+			found:    true,
+		},
+		{
+			name:     "second argument with package",
+			text:     "select {{ dbt_utils.star(ref('a'), |",
+			expected: callContext{Package: "dbt_utils", Name: "star", ActiveParameter: 1, ArgumentText: " ", InJinja: true},
+			found:    true,
+		},
+		{
+			name:     "commas in strings and lists are ignored",
+			text:     "{{ pivot('a,b', ['x', 'y'], |",
+			expected: callContext{Name: "pivot", ActiveParameter: 2, ArgumentText: " ", InJinja: true},
+			found:    true,
+		},
+		{
+			name:     "keyword argument",
+			text:     "{{ star('a', except=|",
+			expected: callContext{Name: "star", ActiveParameter: 1, ArgumentText: " except=", InJinja: true},
+			found:    true,
+		},
+		{
+			name:     "sql call",
+			text:     "select coalesce(a, |",
+			expected: callContext{Name: "coalesce", ActiveParameter: 1, ArgumentText: " "},
+			found:    true,
+		},
+		{
+			name:  "closed jinja tag",
+			text:  "{{ full_name('a' }} |",
+			found: false,
+		},
+		{
+			name:  "closed call",
+			text:  "{{ full_name('a', 'b') |",
+			found: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			offset := strings.Index(tc.text, "|")
+			result, found := findCallContext(tc.text[:offset], offset)
+			if found != tc.found {
+				t.Fatalf("expected found %v, got %v", tc.found, found)
+			}
+			if result != tc.expected {
+				t.Errorf("got: %+v; want: %+v", result, tc.expected)
+			}
+		})
+	}
+}
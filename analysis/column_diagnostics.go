@@ -0,0 +1,132 @@
+package analysis
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/j-clemons/dbt-language-server/lsp"
+	diagnosticseverity "github.com/j-clemons/dbt-language-server/lsp/diagnosticSeverity"
+)
+
+// propertiesYaml parses a properties file, preferring the editor's copy.
+func (s *State) propertiesYaml(path string) PropertiesYaml {
+	doc, open := s.Documents["file://"+path]
+	if !open || doc.Yaml == nil {
+		return parsePropertiesYamlFile(path)
+	}
+	return doc.Yaml.Properties
+}
+
+// modelByName finds a model by its file name, which is also how properties
+// files name it, even when the model map is keyed by an alias. Versioned
+// models are named by their entry rather than their files.
+func (s *State) modelByName(name string) (ModelDetails, bool) {
+	if model, ok := s.DbtContext.ModelDetailMap[name]; ok && (modelFileName(model.URI) == name || len(model.Versions) > 0) {
+		return model, true
+	}
+	for _, model := range s.DbtContext.ModelDetailMap {
+		if modelFileName(model.URI) == name {
+			return model, true
+		}
+	}
+	return ModelDetails{}, false
+}
+
+func modelFileName(path string) string {
+	return strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+}
+
+// columnDiagnostics compares documented columns with the columns models
+// produce: documented columns that are gone are flagged in properties
+// files, and undocumented columns are flagged in the model SQL.
+func (s *State) columnDiagnostics(uri string) []lsp.Diagnostic {
+	path := strings.TrimPrefix(uri, "file://")
+	switch filepath.Ext(path) {
+	case ".yml", ".yaml":
+		return s.staleColumnDiagnostics(path)
+	case ".sql":
+		return s.undocumentedColumnDiagnostics(uri, path)
+	}
+	return nil
+}
+
+func (s *State) staleColumnDiagnostics(path string) []lsp.Diagnostic {
+	diagnostics := []lsp.Diagnostic{}
+
+	for _, props := range s.propertiesYaml(path).Models {
+		model, ok := s.modelByName(props.Name.Value)
+		// without a complete column list nothing can be called stale
+		if !ok || model.InferredColumns == nil {
+			continue
+		}
+		produced := make(map[string]bool)
+		for _, c := range model.InferredColumns {
+			produced[strings.ToLower(c.Name)] = true
+		}
+
+		for _, c := range props.Columns {
+			if c.Name.Value == "" || produced[strings.ToLower(c.Name.Value)] {
+				continue
+			}
+			diagnostics = append(diagnostics, lsp.Diagnostic{
+				Range: lsp.Range{
+					Start: c.Name.Position,
+					End: lsp.Position{
+						Line:      c.Name.Position.Line,
+						Character: c.Name.Position.Character + len(c.Name.Value),
+					},
+				},
+				Message:  fmt.Sprintf("Column '%s' is documented but model '%s' does not produce it", c.Name.Value, props.Name.Value),
+				Severity: diagnosticseverity.Warning,
+				Code:     "stale-column",
+				Source:   diagnosticSource,
+			})
+		}
+	}
+
+	return diagnostics
+}
+
+func (s *State) undocumentedColumnDiagnostics(uri string, path string) []lsp.Diagnostic {
+	diagnostics := []lsp.Diagnostic{}
+
+	name := modelFileName(path)
+	model, ok := s.modelByName(name)
+	if !ok || model.URI != path || model.SchemaURI == "" {
+		return diagnostics
+	}
+
+	var documented map[string]bool
+	for _, props := range s.propertiesYaml(model.SchemaURI).Models {
+		if props.Name.Value != name {
+			continue
+		}
+		documented = make(map[string]bool)
+		for _, c := range props.Columns {
+			documented[strings.ToLower(c.Name.Value)] = true
+		}
+	}
+	if documented == nil {
+		return diagnostics
+	}
+
+	defs, _ := s.documentColumnDefinitions(uri)
+	for _, def := range defs {
+		if documented[strings.ToLower(def.name)] {
+			continue
+		}
+		// repeated names, e.g. from a star over overlapping relations, are
+		// only reported once
+		documented[strings.ToLower(def.name)] = true
+		diagnostics = append(diagnostics, lsp.Diagnostic{
+			Range:    definitionRange(def),
+			Message:  fmt.Sprintf("Column '%s' is not documented in %s", def.name, filepath.Base(model.SchemaURI)),
+			Severity: diagnosticseverity.Info,
+			Code:     "undocumented-column",
+			Source:   diagnosticSource,
+		})
+	}
+
+	return diagnostics
+}
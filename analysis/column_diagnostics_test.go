@@ -0,0 +1,78 @@
+package analysis
+
+import (
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/j-clemons/dbt-language-server/lsp"
+	diagnosticseverity "github.com/j-clemons/dbt-language-server/lsp/diagnosticSeverity"
+	"github.com/j-clemons/dbt-language-server/testutils"
+	"github.com/j-clemons/dbt-language-server/util"
+)
+
+func TestColumnDiagnostics(t *testing.T) {
+	testdataRoot, err := testutils.GetTestdataPath("jaffle_shop_duckdb")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	state := NewState()
+	state.refreshDbtContext(testdataRoot)
+
+	schemaPath := filepath.Join(testdataRoot, "models/schema.yml")
+	schemaText, err := util.ReadFileContents(schemaPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	state.parseDocument("file://"+schemaPath, schemaText)
+
+	// orders.sql builds columns in a jinja loop, so only customers is checked
+	expected := []lsp.Diagnostic{
+		{
+			Range:    lsp.Range{Start: lsp.Position{Line: 28, Character: 14}, End: lsp.Position{Line: 28, Character: 32}},
+			Message:  "Column 'total_order_amount' is documented but model 'customers' does not produce it",
+			Severity: diagnosticseverity.Warning,
+			Code:     "stale-column",
+			Source:   diagnosticSource,
+		},
+	}
+	if actual := state.Diagnostics("file://" + schemaPath); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("schema.yml: expected %v,\n\ngot %v", expected, actual)
+	}
+
+	modelPath := filepath.Join(testdataRoot, "models/customers.sql")
+	modelText, err := util.ReadFileContents(modelPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	state.parseDocument("file://"+modelPath, modelText)
+
+	undocumented := func(name string, line int, start int) lsp.Diagnostic {
+		return lsp.Diagnostic{
+			Range:    lsp.Range{Start: lsp.Position{Line: line, Character: start}, End: lsp.Position{Line: line, Character: start + len(name)}},
+			Message:  "Column '" + name + "' is not documented in schema.yml",
+			Severity: diagnosticseverity.Info,
+			Code:     "undocumented-column",
+			Source:   diagnosticSource,
+		}
+	}
+	expected = []lsp.Diagnostic{
+		undocumented("jaffle_string", 56, 38),
+		undocumented("full_name", 60, 54),
+		undocumented("lifetime_order_number", 64, 48),
+		undocumented("customer_lifetime_value", 65, 42),
+	}
+	if actual := state.Diagnostics("file://" + modelPath); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("customers.sql: expected %v,\n\ngot %v", expected, actual)
+	}
+
+	// documenting the column in the open schema clears the hint
+	state.parseDocument("file://"+schemaPath, strings.Replace(schemaText, "      - name: first_name", "      - name: jaffle_string\n      - name: first_name", 1))
+	for _, d := range state.Diagnostics("file://" + modelPath) {
+		if d.Message == expected[0].Message {
+			t.Errorf("expected jaffle_string to be documented")
+		}
+	}
+}
@@ -0,0 +1,445 @@
+package analysis
+
+import (
+	"fmt"
+	"maps"
+	"strings"
+
+	"github.com/j-clemons/dbt-language-server/analysis/parser"
+	"github.com/j-clemons/dbt-language-server/lsp"
+	"github.com/j-clemons/dbt-language-server/util"
+)
+
+// maxLineageDepth bounds how many CTEs, subqueries and columns a lookup may
+// pass through, guarding against cycles such as recursive CTEs.
+const maxLineageDepth = 64
+
+// lineageScope maps the lower case names of the CTEs visible to a query to
+// their definitions.
+type lineageScope map[string]cteDefinition
+
+type cteDefinition struct {
+	query *parser.Query
+	// scope is what the CTE body can see: the CTEs declared before it.
+	scope lineageScope
+}
+
+func (sc lineageScope) with(ctes []*parser.QueryCTE) lineageScope {
+	scope := maps.Clone(sc)
+	if scope == nil {
+		scope = lineageScope{}
+	}
+	for _, cte := range ctes {
+		scope[strings.ToLower(cte.Name.Literal)] = cteDefinition{query: cte.Query, scope: maps.Clone(scope)}
+	}
+	return scope
+}
+
+// columnDefinition is where an output column of a query is computed.
+type columnDefinition struct {
+	name string
+	// token is the column name as written, when the column is named in the
+	// select list rather than expanded from a star.
+	token *parser.Token
+	item  *parser.SelectItem
+	sel   *parser.Select
+	scope lineageScope
+	// table and column are set for columns read straight from a model or
+	// source.
+	table  *parser.TableRef
+	column string
+	// alternatives are the same column in the other branches of a UNION.
+	alternatives []columnDefinition
+}
+
+type columnMatch int
+
+const (
+	columnMissing columnMatch = iota
+	// columnGuessed means the table's columns are unknown, so it may or may
+	// not have the column.
+	columnGuessed
+	columnFound
+)
+
+type lineageResolver struct {
+	s *State
+	// infer, when set, is called before reading a model's columns so they
+	// can be inferred on demand.
+	infer func(key ReferenceKey)
+}
+
+func (r lineageResolver) relationColumns(table *parser.TableRef) []Column {
+	rel := tableRelation(table)
+	if r.infer != nil && table.Kind == parser.TableModel {
+		r.infer(rel.modelKey())
+	}
+	return r.s.relationColumns(rel)
+}
+
+// starTables returns the tables a `*`, `alias.*` or dbt_utils.star() item
+// expands.
+func starTables(sel *parser.Select, item *parser.SelectItem) []*parser.TableRef {
+	if item.StarRelation != nil {
+		return []*parser.TableRef{item.StarRelation}
+	}
+	if item.StarQualifier == "" {
+		return sel.From
+	}
+	for _, table := range sel.From {
+		if strings.EqualFold(table.Qualifier(), item.StarQualifier) {
+			return []*parser.TableRef{table}
+		}
+	}
+	return nil
+}
+
+func starExcludes(item *parser.SelectItem, name string) bool {
+	for _, except := range item.StarExcept {
+		if strings.EqualFold(except, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// unionAligned reports whether the columns of every UNION branch can be
+// matched up by position.
+func unionAligned(query *parser.Query) bool {
+	for _, sel := range query.Selects {
+		if len(sel.Items) != len(query.Selects[0].Items) {
+			return false
+		}
+		for _, item := range sel.Items {
+			if item.Star {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func namedDefinition(query *parser.Query, scope lineageScope, index int) columnDefinition {
+	sel := query.Selects[0]
+	item := sel.Items[index]
+	name, _ := item.OutputName()
+	def := columnDefinition{name: name.Literal, token: &name, item: item, sel: sel, scope: scope}
+	if len(query.Selects) > 1 && unionAligned(query) {
+		for _, branch := range query.Selects[1:] {
+			def.alternatives = append(def.alternatives, columnDefinition{
+				name:  def.name,
+				item:  branch.Items[index],
+				sel:   branch,
+				scope: scope,
+			})
+		}
+	}
+	return def
+}
+
+// definitions lists the output columns of query. complete is false when
+// some columns can't be named: stars over tables whose columns are unknown,
+// expressions without an alias and names built by jinja.
+func (r lineageResolver) definitions(query *parser.Query, scope lineageScope, depth int) (defs []columnDefinition, complete bool) {
+	defs = []columnDefinition{}
+	if query == nil || len(query.Selects) == 0 || depth > maxLineageDepth {
+		return defs, false
+	}
+	scope = scope.with(query.CTEs)
+
+	complete = true
+	sel := query.Selects[0]
+	for i, item := range sel.Items {
+		if !item.Star {
+			name, ok := item.OutputName()
+			if !ok || strings.Contains(name.Literal, "{{") {
+				complete = false
+				continue
+			}
+			defs = append(defs, namedDefinition(query, scope, i))
+			continue
+		}
+		tables := starTables(sel, item)
+		if len(tables) == 0 {
+			complete = false
+		}
+		for _, table := range tables {
+			tableDefs, tableComplete := r.tableDefinitions(table, scope, depth+1)
+			complete = complete && tableComplete
+			for _, def := range tableDefs {
+				if starExcludes(item, def.name) {
+					continue
+				}
+				def.name = item.StarPrefix + def.name + item.StarSuffix
+				if def.table != nil {
+					def.token, def.item, def.sel = nil, item, sel
+				}
+				defs = append(defs, def)
+			}
+		}
+	}
+	return defs, complete
+}
+
+func (r lineageResolver) tableDefinitions(table *parser.TableRef, scope lineageScope, depth int) ([]columnDefinition, bool) {
+	switch table.Kind {
+	case parser.TableSubquery:
+		return r.definitions(table.Subquery, scope, depth)
+	case parser.TableName:
+		if cte, ok := scope[strings.ToLower(table.Name)]; ok {
+			return r.definitions(cte.query, cte.scope, depth)
+		}
+		return nil, false
+	}
+
+	columns := r.relationColumns(table)
+	defs := []columnDefinition{}
+	for _, c := range columns {
+		defs = append(defs, columnDefinition{name: c.Name, table: table, column: c.Name})
+	}
+	return defs, len(columns) > 0
+}
+
+// lookup finds the output column called name in query.
+func (r lineageResolver) lookup(query *parser.Query, scope lineageScope, name string, depth int) (columnDefinition, columnMatch) {
+	if query == nil || len(query.Selects) == 0 || depth > maxLineageDepth {
+		return columnDefinition{}, columnMissing
+	}
+	scope = scope.with(query.CTEs)
+
+	sel := query.Selects[0]
+	for i, item := range sel.Items {
+		if output, ok := item.OutputName(); ok && !item.Star && strings.EqualFold(output.Literal, name) {
+			return namedDefinition(query, scope, i), columnFound
+		}
+	}
+
+	best, match := columnDefinition{}, columnMissing
+	for _, item := range sel.Items {
+		if !item.Star {
+			continue
+		}
+		if len(name) < len(item.StarPrefix)+len(item.StarSuffix) ||
+			!strings.EqualFold(name[:len(item.StarPrefix)], item.StarPrefix) ||
+			!strings.EqualFold(name[len(name)-len(item.StarSuffix):], item.StarSuffix) {
+			continue
+		}
+		column := name[len(item.StarPrefix) : len(name)-len(item.StarSuffix)]
+		if starExcludes(item, column) {
+			continue
+		}
+		for _, table := range starTables(sel, item) {
+			def, m := r.tableLookup(table, scope, column, depth+1)
+			if m <= match {
+				continue
+			}
+			def.name = name
+			if def.table != nil {
+				def.item, def.sel = item, sel
+			}
+			best, match = def, m
+			if match == columnFound {
+				return best, match
+			}
+		}
+	}
+	return best, match
+}
+
+func (r lineageResolver) tableLookup(table *parser.TableRef, scope lineageScope, name string, depth int) (columnDefinition, columnMatch) {
+	switch table.Kind {
+	case parser.TableSubquery:
+		return r.lookup(table.Subquery, scope, name, depth)
+	case parser.TableName:
+		if cte, ok := scope[strings.ToLower(table.Name)]; ok {
+			return r.lookup(cte.query, cte.scope, name, depth)
+		}
+		// a table outside of dbt
+		return columnDefinition{}, columnMissing
+	}
+
+	columns := r.relationColumns(table)
+	if len(columns) == 0 {
+		return columnDefinition{name: name, table: table, column: name}, columnGuessed
+	}
+	for _, c := range columns {
+		if strings.EqualFold(c.Name, name) {
+			return columnDefinition{name: c.Name, table: table, column: c.Name}, columnFound
+		}
+	}
+	return columnDefinition{}, columnMissing
+}
+
+// resolveColumnRef traces a column read by an expression in sel. An
+// unqualified column no table is known to have is attributed to the first
+// table whose columns are unknown.
+func (r lineageResolver) resolveColumnRef(sel *parser.Select, scope lineageScope, ref parser.ColumnRef, depth int) []lsp.UpstreamColumn {
+	best, match := columnDefinition{}, columnMissing
+	for _, table := range sel.From {
+		if ref.Qualifier != "" && !strings.EqualFold(table.Qualifier(), ref.Qualifier) {
+			continue
+		}
+		def, m := r.tableLookup(table, scope, ref.Name.Literal, depth+1)
+		if m > match {
+			best, match = def, m
+		}
+		if match == columnFound {
+			break
+		}
+	}
+	if match == columnMissing {
+		return nil
+	}
+	return r.upstream(best, depth+1)
+}
+
+// upstream returns the model and source columns def is computed from.
+func (r lineageResolver) upstream(def columnDefinition, depth int) []lsp.UpstreamColumn {
+	columns := []lsp.UpstreamColumn{}
+	if depth > maxLineageDepth {
+		return columns
+	}
+
+	if def.table != nil {
+		columns = append(columns, r.upstreamColumn(def.table, def.column))
+	} else if def.item != nil {
+		for _, ref := range def.item.Columns {
+			columns = append(columns, r.resolveColumnRef(def.sel, def.scope, ref, depth)...)
+		}
+	}
+	for _, alt := range def.alternatives {
+		columns = append(columns, r.upstream(alt, depth+1)...)
+	}
+
+	unique := []lsp.UpstreamColumn{}
+	seen := make(map[lsp.UpstreamColumn]bool)
+	for _, c := range columns {
+		if !seen[c] {
+			seen[c] = true
+			unique = append(unique, c)
+		}
+	}
+	return unique
+}
+
+func (r lineageResolver) upstreamColumn(table *parser.TableRef, column string) lsp.UpstreamColumn {
+	if table.Kind == parser.TableSource {
+		upstream := lsp.UpstreamColumn{Type: "source", Source: table.Source, Name: table.Name, Column: column}
+		if sourceTable, ok := r.s.DbtContext.SourceDetailMap[table.Source].Tables[table.Name]; ok && sourceTable.URI != "" {
+			upstream.URI = "file://" + sourceTable.URI
+		}
+		return upstream
+	}
+
+	upstream := lsp.UpstreamColumn{Type: "model", Name: table.Name, Column: column}
+	if model, ok := r.s.resolveModel(tableRelation(table).modelKey()); ok && model.URI != "" {
+		upstream.URI = "file://" + model.URI
+	}
+	return upstream
+}
+
+func tableRelation(table *parser.TableRef) relation {
+	if table.Kind == parser.TableSource {
+		return relation{Type: parser.SOURCE_TABLE, Source: table.Source, Name: table.Name}
+	}
+	return relation{Type: parser.REF, Package: table.Package, Name: table.Name, Version: table.Version}
+}
+
+// itemText returns the source text of a select item's expression.
+func itemText(text string, item *parser.SelectItem) string {
+	start := util.GetOffset(text, item.Start.Line, item.Start.Column)
+	end := util.GetOffset(text, item.ExprEnd.Line, item.ExprEnd.Column+len(item.ExprEnd.Literal))
+	if start >= end {
+		return ""
+	}
+	return text[start:end]
+}
+
+// documentColumnDefinitions returns the output columns of the model in uri.
+func (s *State) documentColumnDefinitions(uri string) ([]columnDefinition, bool) {
+	doc, ok := s.Documents[uri]
+	if !ok || doc.Tokens == nil {
+		return nil, false
+	}
+	query := parser.ParseQuery(doc.Tokens.Tokens())
+	if query == nil {
+		return nil, false
+	}
+	return lineageResolver{s: s}.definitions(query, lineageScope{}, 0)
+}
+
+// definitionRange is where a column is named, or the star it expands from.
+func definitionRange(def columnDefinition) lsp.Range {
+	if def.token != nil {
+		return tokenRange(*def.token)
+	}
+	return tokenSpan(def.item.Start, def.item.End)
+}
+
+// documentColumnLineage traces every output column of the model in uri.
+func (s *State) documentColumnLineage(uri string) []lsp.ColumnLineage {
+	lineage := []lsp.ColumnLineage{}
+
+	defs, _ := s.documentColumnDefinitions(uri)
+	r := lineageResolver{s: s}
+	for _, def := range defs {
+		lineage = append(lineage, lsp.ColumnLineage{
+			Name:       def.name,
+			Range:      definitionRange(def),
+			Expression: itemText(s.Documents[uri].Text, def.item),
+			Upstream:   r.upstream(def, 0),
+		})
+	}
+	return lineage
+}
+
+func (s *State) ColumnLineage(id lsp.RequestID, params lsp.ColumnLineageParams) lsp.ColumnLineageResponse {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	s = s.project(params.TextDocument.URI)
+
+	response := lsp.ColumnLineageResponse{
+		Response: lsp.Response{
+			RPC: "2.0",
+			ID:  id,
+		},
+		Result: []lsp.ColumnLineage{},
+	}
+
+	for _, column := range s.documentColumnLineage(params.TextDocument.URI) {
+		if params.Column != "" && !strings.EqualFold(column.Name, params.Column) {
+			continue
+		}
+		if params.Position != nil && !rangeContains(column.Range, lsp.Range{Start: *params.Position, End: *params.Position}) {
+			continue
+		}
+		response.Result = append(response.Result, column)
+	}
+	return response
+}
+
+// columnLineageMarkdown describes the output column named by token, for
+// hover.
+func (s *State) columnLineageMarkdown(uri string, token parser.Token) string {
+	for _, column := range s.documentColumnLineage(uri) {
+		if column.Range != tokenRange(token) {
+			continue
+		}
+
+		var b strings.Builder
+		b.WriteString(fmt.Sprintf("**%s**\n```sql\n%s\n```\n", column.Name, column.Expression))
+		if len(column.Upstream) == 0 {
+			return b.String()
+		}
+		b.WriteString("\nUpstream columns:\n")
+		for _, upstream := range column.Upstream {
+			if upstream.Type == "source" {
+				b.WriteString(fmt.Sprintf("- `%s.%s.%s` (source)\n", upstream.Source, upstream.Name, upstream.Column))
+			} else {
+				b.WriteString(fmt.Sprintf("- `%s.%s`\n", upstream.Name, upstream.Column))
+			}
+		}
+		return b.String()
+	}
+	return ""
+}
@@ -0,0 +1,205 @@
+package analysis
+
+import (
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/j-clemons/dbt-language-server/analysis/parser"
+	"github.com/j-clemons/dbt-language-server/lsp"
+)
+
+func TestColumnLineage(t *testing.T) {
+	contents, err := os.ReadFile("../testdata/jaffle_shop_duckdb/models/customers.sql")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	state := columnTestState()
+	state.DbtContext.ModelDetailMap["stg_payments"] = ModelDetails{URI: "/project/models/stg_payments.sql"}
+	uri := "file:///project/models/customers.sql"
+	state.parseDocument(uri, string(contents))
+
+	lineage := state.documentColumnLineage(uri)
+	names := []string{}
+	for _, column := range lineage {
+		names = append(names, column.Name)
+	}
+	expectedNames := []string{
+		"jaffle_string", "customer_id", "first_name", "last_name", "full_name",
+		"first_order", "most_recent_order", "number_of_orders",
+		"lifetime_order_number", "customer_lifetime_value", "lifetime_order_number",
+	}
+	if !reflect.DeepEqual(names, expectedNames) {
+		t.Fatalf("expected columns %v, got %v", expectedNames, names)
+	}
+
+	tests := []struct {
+		index      int
+		expression string
+		upstream   []lsp.UpstreamColumn
+	}{
+		{
+			index:      0,
+			expression: "{{ var('jaffle_string') }}",
+			upstream:   []lsp.UpstreamColumn{},
+		},
+		{
+			index:      1,
+			expression: "customers.customer_id",
+			upstream:   []lsp.UpstreamColumn{{Type: "model", Name: "stg_customers", Column: "customer_id"}},
+		},
+		{
+			index:      7,
+			expression: "customer_orders.number_of_orders",
+			upstream:   []lsp.UpstreamColumn{{Type: "model", Name: "stg_orders", Column: "order_id", URI: "file:///project/models/stg_orders.sql"}},
+		},
+		{
+			// stg_orders has catalog columns, none of them order_date
+			index:      5,
+			expression: "customer_orders.first_order",
+			upstream:   []lsp.UpstreamColumn{},
+		},
+		{
+			index:      9,
+			expression: "customer_payments.total_amount",
+			upstream: []lsp.UpstreamColumn{
+				{Type: "model", Name: "stg_payments", Column: "amount", URI: "file:///project/models/stg_payments.sql"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		column := lineage[tt.index]
+		if column.Expression != tt.expression {
+			t.Errorf("%s: expected expression %q, got %q", column.Name, tt.expression, column.Expression)
+		}
+		if !reflect.DeepEqual(column.Upstream, tt.upstream) {
+			t.Errorf("%s: expected upstream %v, got %v", column.Name, tt.upstream, column.Upstream)
+		}
+	}
+
+	expectedRange := lsp.Range{
+		Start: lsp.Position{Line: 65, Character: 42},
+		End:   lsp.Position{Line: 65, Character: 65},
+	}
+	if lineage[9].Range != expectedRange {
+		t.Errorf("expected range %v, got %v", expectedRange, lineage[9].Range)
+	}
+}
+
+func TestColumnLineageResolution(t *testing.T) {
+	state := columnTestState()
+	uri := "file:///project/models/order_payments.sql"
+	state.parseDocument(uri, `with orders as (
+    select * exclude (status) from {{ ref('stg_orders') }}
+),
+
+recent as (
+    select o.order_id as id, o.order_id + 1 as next_id
+    from (select * from orders) o
+)
+
+select
+    recent.id,
+    p.amount * 2 as doubled,
+    order_id
+from recent
+join {{ source('raw', 'payments') }} p on p.order_id = recent.id
+join orders on true
+union all
+select legacy_id, legacy_amount, 1 from {{ ref('legacy_orders') }}`)
+
+	response := state.ColumnLineage(1, lsp.ColumnLineageParams{
+		TextDocument: lsp.TextDocumentIdentifier{URI: uri},
+	})
+
+	stgOrderID := lsp.UpstreamColumn{Type: "model", Name: "stg_orders", Column: "order_id", URI: "file:///project/models/stg_orders.sql"}
+	expected := map[string][]lsp.UpstreamColumn{
+		"id": {stgOrderID, {Type: "model", Name: "legacy_orders", Column: "legacy_id"}},
+		"doubled": {
+			{Type: "source", Source: "raw", Name: "payments", Column: "amount"},
+			{Type: "model", Name: "legacy_orders", Column: "legacy_amount"},
+		},
+		"order_id": {stgOrderID},
+	}
+
+	actual := map[string][]lsp.UpstreamColumn{}
+	for _, column := range response.Result {
+		actual[column.Name] = column.Upstream
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected %v,\n\ngot %v", expected, actual)
+	}
+
+	filtered := state.ColumnLineage(2, lsp.ColumnLineageParams{
+		TextDocument: lsp.TextDocumentIdentifier{URI: uri},
+		Position:     &lsp.Position{Line: 11, Character: 20},
+	})
+	if len(filtered.Result) != 1 || filtered.Result[0].Name != "doubled" {
+		t.Fatalf("expected only doubled, got %v", filtered.Result)
+	}
+	if filtered.Result[0].Expression != "p.amount * 2" {
+		t.Errorf("unexpected expression %q", filtered.Result[0].Expression)
+	}
+
+	byName := state.ColumnLineage(3, lsp.ColumnLineageParams{
+		TextDocument: lsp.TextDocumentIdentifier{URI: uri},
+		Column:       "ORDER_ID",
+	})
+	if len(byName.Result) != 1 || byName.Result[0].Name != "order_id" {
+		t.Fatalf("expected only order_id, got %v", byName.Result)
+	}
+}
+
+func TestColumnLineageStarExpansion(t *testing.T) {
+	state := columnTestState()
+	uri := "file:///project/models/orders.sql"
+	state.parseDocument(uri, `select * from {{ ref('stg_orders') }}`)
+
+	lineage := state.documentColumnLineage(uri)
+	if len(lineage) != 2 || lineage[0].Name != "order_id" || lineage[1].Name != "status" {
+		t.Fatalf("expected catalog columns, got %v", lineage)
+	}
+	if lineage[1].Expression != "*" || lineage[1].Upstream[0].Column != "status" {
+		t.Errorf("unexpected lineage %v", lineage[1])
+	}
+}
+
+func TestColumnLineageRecursiveCTE(t *testing.T) {
+	state := columnTestState()
+	uri := "file:///project/models/numbers.sql"
+	state.parseDocument(uri, `with recursive numbers as (
+    select 1 as n
+    union all
+    select n + 1 from numbers where n < 10
+)
+select n from numbers`)
+
+	lineage := state.documentColumnLineage(uri)
+	if len(lineage) != 1 || lineage[0].Name != "n" || len(lineage[0].Upstream) != 0 {
+		t.Fatalf("unexpected lineage %v", lineage)
+	}
+}
+
+func TestColumnLineageHover(t *testing.T) {
+	state := columnTestState()
+	uri := "file:///project/models/orders.sql"
+	state.parseDocument(uri, `select
+    o.order_id as id,
+    upper(o.status) as status_upper
+from {{ ref('stg_orders') }} o`)
+
+	response := state.Hover(1, uri, lsp.Position{Line: 2, Character: 24})
+	expected := "**status_upper**\n```sql\nupper(o.status)\n```\n\nUpstream columns:\n- `stg_orders.status`\n"
+	if response.Result.Contents != expected {
+		t.Fatalf("expected %q, got %q", expected, response.Result.Contents)
+	}
+
+	if contents := state.columnLineageMarkdown(uri, parser.Token{Literal: "id", Line: 9}); contents != "" {
+		t.Errorf("expected no hover away from the select list, got %q", contents)
+	}
+	if !strings.Contains(state.Hover(2, uri, lsp.Position{Line: 1, Character: 19}).Result.Contents, "`stg_orders.order_id`") {
+		t.Error("expected lineage hover on id")
+	}
+}
@@ -0,0 +1,261 @@
+package analysis
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/j-clemons/dbt-language-server/analysis/parser"
+	"github.com/j-clemons/dbt-language-server/lsp"
+	"github.com/j-clemons/dbt-language-server/lsp/completionKind"
+)
+
+// relation is a model or source table selected from in a document. Package
+// and Version are the arguments of a ref.
+type relation struct {
+	Type    parser.TokenType
+	Source  string
+	Package string
+	Name    string
+	Version string
+}
+
+// modelKey is the ref a model relation was read from.
+func (r relation) modelKey() ReferenceKey {
+	return ReferenceKey{Type: parser.REF, Package: r.Package, Name: r.Name, Version: r.Version}
+}
+
+// sqlClauseKeywords may directly follow a relation, so they are never
+// treated as its alias.
+var sqlClauseKeywords = map[string]bool{
+	"where": true, "join": true, "left": true, "right": true, "inner": true,
+	"outer": true, "full": true, "cross": true, "natural": true, "lateral": true,
+	"on": true, "using": true, "group": true, "order": true, "limit": true,
+	"union": true, "except": true, "intersect": true, "having": true,
+	"qualify": true, "window": true, "select": true, "from": true, "as": true,
+}
+
+var identRegex = regexp.MustCompile(`^[A-Za-z_]\w*$`)
+
+func (s *State) relationColumns(r relation) []Column {
+	if r.Type == parser.REF {
+		model, _ := s.resolveModel(r.modelKey())
+		if len(model.Columns) > 0 {
+			return model.Columns
+		}
+		return model.InferredColumns
+	}
+	return s.DbtContext.SourceDetailMap[r.Source].Tables[r.Name].Columns
+}
+
+// aliasAfter returns the alias following tokens[j-1], with or without AS.
+func aliasAfter(tokens []parser.TokenLL, j int) (string, bool) {
+	if j < len(tokens) && strings.ToLower(tokens[j].Token.Literal) == "as" {
+		j++
+	}
+	if j >= len(tokens) {
+		return "", false
+	}
+	literal := tokens[j].Token.Literal
+	if !identRegex.MatchString(literal) || sqlClauseKeywords[strings.ToLower(literal)] {
+		return "", false
+	}
+	return literal, true
+}
+
+// relationAliases maps every name usable as a column qualifier of a ref or
+// source, e.g. `o` in `from {{ ref('orders') }} o`, to the relation. Keys
+// are lower case since SQL identifiers are case-insensitive.
+func relationAliases(tokens []parser.TokenLL, projectName string) map[string]relation {
+	aliases := make(map[string]relation)
+
+	for i := range tokens {
+		key, ok := referenceKeyFromToken(&tokens[i], projectName)
+		if !ok || (key.Type != parser.REF && key.Type != parser.SOURCE_TABLE) {
+			continue
+		}
+		rel := relation{Type: key.Type, Package: key.Package, Name: key.Name, Version: key.Version}
+		if key.Type == parser.SOURCE_TABLE {
+			rel = relation{Type: key.Type, Source: key.Package, Name: key.Name}
+		}
+
+		end := i
+		for end < len(tokens) && tokens[end].Token.Type != parser.DB_RBRACE {
+			end++
+		}
+		if alias, ok := aliasAfter(tokens, end+1); ok {
+			aliases[strings.ToLower(alias)] = rel
+		}
+		if _, exists := aliases[strings.ToLower(key.Name)]; !exists && key.Type == parser.REF {
+			aliases[strings.ToLower(key.Name)] = rel
+		}
+	}
+
+	return aliases
+}
+
+// queryTable finds the CTE or subquery that qualifier names in query, such
+// as a CTE's name or `o` in `from orders o`, along with the CTEs it sees.
+func queryTable(query *parser.Query, scope lineageScope, qualifier string, depth int) (*parser.TableRef, lineageScope, bool) {
+	if query == nil || depth > maxLineageDepth {
+		return nil, nil, false
+	}
+	scope = scope.with(query.CTEs)
+
+	for _, sel := range query.Selects {
+		for _, table := range sel.From {
+			if table.Kind != parser.TableName && table.Kind != parser.TableSubquery {
+				continue
+			}
+			if strings.EqualFold(table.Qualifier(), qualifier) {
+				return table, scope, true
+			}
+			if found, foundScope, ok := queryTable(table.Subquery, scope, qualifier, depth+1); ok {
+				return found, foundScope, true
+			}
+		}
+	}
+	for _, cte := range query.CTEs {
+		if found, foundScope, ok := queryTable(cte.Query, scope[strings.ToLower(cte.Name.Literal)].scope, qualifier, depth+1); ok {
+			return found, foundScope, true
+		}
+	}
+	if _, ok := scope[strings.ToLower(qualifier)]; ok {
+		return &parser.TableRef{Kind: parser.TableName, Name: qualifier}, scope, true
+	}
+	return nil, nil, false
+}
+
+// plainColumn reports whether a select item is just a column, such as
+// `status` or `o.status as order_status`.
+func plainColumn(item *parser.SelectItem) bool {
+	if item == nil || item.Star || len(item.Columns) != 1 {
+		return false
+	}
+	ref := item.Columns[0]
+	return item.ExprEnd == ref.Name && (item.Start == ref.Name || strings.EqualFold(item.Start.Literal, ref.Qualifier))
+}
+
+// readColumn follows a column that is only selected and renamed back to the
+// model or source column it reads.
+func (r lineageResolver) readColumn(def columnDefinition, depth int) (Column, bool) {
+	if depth > maxLineageDepth {
+		return Column{}, false
+	}
+	if def.table != nil {
+		for _, c := range r.relationColumns(def.table) {
+			if strings.EqualFold(c.Name, def.column) {
+				return c, true
+			}
+		}
+		return Column{}, false
+	}
+	if !plainColumn(def.item) {
+		return Column{}, false
+	}
+
+	ref := def.item.Columns[0]
+	for _, table := range def.sel.From {
+		if ref.Qualifier != "" && !strings.EqualFold(table.Qualifier(), ref.Qualifier) {
+			continue
+		}
+		if read, match := r.tableLookup(table, def.scope, ref.Name.Literal, depth+1); match == columnFound {
+			return r.readColumn(read, depth+1)
+		}
+	}
+	return Column{}, false
+}
+
+// queryColumns returns the output columns of the CTE or subquery qualifier
+// names in the document, as the lineage resolver sees them. Columns only
+// selected from a model or source keep its types and descriptions.
+func (s *State) queryColumns(tokens []parser.TokenLL, qualifier string) ([]Column, bool) {
+	table, scope, ok := queryTable(parser.ParseQuery(tokens), lineageScope{}, qualifier, 0)
+	if !ok {
+		return nil, false
+	}
+
+	r := lineageResolver{s: s}
+	defs, _ := r.tableDefinitions(table, scope, 0)
+	columns := []Column{}
+	seen := make(map[string]bool)
+	for _, def := range defs {
+		if seen[strings.ToLower(def.name)] {
+			continue
+		}
+		seen[strings.ToLower(def.name)] = true
+
+		column, _ := r.readColumn(def, 0)
+		column.Name = def.name
+		columns = append(columns, column)
+	}
+	return columns, true
+}
+
+var qualifiedColumnRegex = regexp.MustCompile(`(\w+)\.\w*$`)
+
+// getColumnCompletionItems completes `alias.` with the columns of the
+// relation the alias resolves to.
+func (s *State) getColumnCompletionItems(uri string, textBeforeCursor string) ([]lsp.CompletionItem, bool) {
+	match := qualifiedColumnRegex.FindStringSubmatch(textBeforeCursor)
+	if match == nil {
+		return nil, false
+	}
+
+	doc := s.Documents[uri]
+	if doc.Tokens == nil {
+		return nil, false
+	}
+
+	tokens := doc.Tokens.Tokens()
+	columns, ok := s.queryColumns(tokens, match[1])
+	if !ok {
+		rel, found := relationAliases(tokens, s.DbtContext.ProjectYaml.ProjectName.Value)[strings.ToLower(match[1])]
+		if !found {
+			return nil, false
+		}
+		columns = s.relationColumns(rel)
+	}
+	if len(columns) == 0 {
+		return nil, false
+	}
+
+	items := make([]lsp.CompletionItem, 0, len(columns))
+	for i, c := range columns {
+		items = append(items, lsp.CompletionItem{
+			Label:         c.Name,
+			Detail:        c.Type,
+			Documentation: c.Comment,
+			Kind:          completionKind.Field,
+			InsertText:    c.Name,
+			SortText:      fmt.Sprintf("%04d", i),
+		})
+	}
+	return items, true
+}
+
+// columnTable renders columns as a markdown table for hover.
+func columnTable(columns []Column) string {
+	if len(columns) == 0 {
+		return ""
+	}
+
+	var b strings.Builder
+	b.WriteString("| Column | Type | Description |\n| --- | --- | --- |\n")
+	for _, c := range columns {
+		comment := strings.ReplaceAll(c.Comment, "\n", " ")
+		b.WriteString(fmt.Sprintf("| %s | %s | %s |\n", c.Name, c.Type, comment))
+	}
+	return b.String()
+}
+
+func withColumnTable(description string, columns []Column) string {
+	table := columnTable(columns)
+	if table == "" {
+		return description
+	}
+	if description == "" {
+		return table
+	}
+	return description + "\n\n" + table
+}
@@ -0,0 +1,178 @@
+package analysis
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/j-clemons/dbt-language-server/analysis/parser"
+	"github.com/j-clemons/dbt-language-server/lsp"
+	"github.com/j-clemons/dbt-language-server/lsp/completionKind"
+	"github.com/j-clemons/dbt-language-server/testutils"
+)
+
+func columnTestState() *State {
+	state := NewState()
+	state.DbtContext.Dialect = "duckdb"
+	state.DbtContext.ProjectYaml.ProjectName.Value = "demo"
+	state.DbtContext.ModelDetailMap = map[string]ModelDetails{
+		"stg_orders": {
+			URI:         "/project/models/stg_orders.sql",
+			Description: "Staged orders",
+			Columns: []Column{
+				{Name: "order_id", Type: "INTEGER", Comment: "Primary key"},
+				{Name: "status", Type: "VARCHAR"},
+			},
+		},
+	}
+	state.DbtContext.SourceDetailMap = map[string]Source{
+		"raw": {
+			Name: "raw",
+			Tables: map[string]SourceTable{
+				"payments": {Name: "payments", Table: "raw", Columns: []Column{{Name: "amount", Type: "DOUBLE"}}},
+			},
+		},
+	}
+	return &state
+}
+
+func TestRelationAliases(t *testing.T) {
+	state := columnTestState()
+	uri := "file:///project/models/orders.sql"
+	state.parseDocument(uri, `with orders as (
+    select * from {{ ref('stg_orders') }}
+)
+select *
+from orders as o
+left join {{ source('raw', 'payments') }} p on o.order_id = p.order_id
+join {{ ref('stg_customers') }} where 1 = 1`)
+
+	doc := state.Documents[uri]
+	actual := relationAliases(doc.Tokens.Tokens(), "demo")
+
+	// the orders CTE and its alias are resolved through the query instead
+	expected := map[string]relation{
+		"stg_orders":    {Type: parser.REF, Name: "stg_orders"},
+		"p":             {Type: parser.SOURCE_TABLE, Source: "raw", Name: "payments"},
+		"stg_customers": {Type: parser.REF, Name: "stg_customers"},
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected %v,\n\ngot %v", expected, actual)
+	}
+}
+
+func TestColumnCompletion(t *testing.T) {
+	state := columnTestState()
+	uri := "file:///project/models/orders.sql"
+	state.parseDocument(uri, `select o.
+from {{ ref('stg_orders') }} o
+join {{ source('raw', 'payments') }} as p on true`)
+
+	response := state.TextDocumentCompletion(1, uri, lsp.Position{Line: 0, Character: 9})
+	expected := []lsp.CompletionItem{
+		{Label: "order_id", Detail: "INTEGER", Documentation: "Primary key", Kind: completionKind.Field, InsertText: "order_id", SortText: "0000"},
+		{Label: "status", Detail: "VARCHAR", Kind: completionKind.Field, InsertText: "status", SortText: "0001"},
+	}
+	if !reflect.DeepEqual(response.Result, expected) {
+		t.Fatalf("expected %v,\n\ngot %v", expected, response.Result)
+	}
+
+	if items, ok := state.getColumnCompletionItems(uri, "select p.am"); !ok || len(items) != 1 || items[0].Label != "amount" {
+		t.Fatalf("expected source columns for p, got %v", items)
+	}
+	if _, ok := state.getColumnCompletionItems(uri, "select x."); ok {
+		t.Fatal("expected no column completion for an unknown alias")
+	}
+}
+
+func TestCteColumnCompletion(t *testing.T) {
+	state := columnTestState()
+	uri := "file:///project/models/orders.sql"
+	state.parseDocument(uri, `with orders as (
+    select 1 as one, order_id, status as order_status from {{ ref('stg_orders') }}
+),
+renamed as (
+    select * from orders
+)
+select o.
+from renamed o
+join {{ source('raw', 'payments') }} p on true`)
+
+	expected := []lsp.CompletionItem{
+		{Label: "one", Kind: completionKind.Field, InsertText: "one", SortText: "0000"},
+		{Label: "order_id", Detail: "INTEGER", Documentation: "Primary key", Kind: completionKind.Field, InsertText: "order_id", SortText: "0001"},
+		{Label: "order_status", Detail: "VARCHAR", Kind: completionKind.Field, InsertText: "order_status", SortText: "0002"},
+	}
+	for _, qualifier := range []string{"o", "renamed", "orders"} {
+		items, ok := state.getColumnCompletionItems(uri, "select "+qualifier+".")
+		if !ok || !reflect.DeepEqual(items, expected) {
+			t.Errorf("%s: expected %v,\n\ngot %v", qualifier, expected, items)
+		}
+	}
+	if items, ok := state.getColumnCompletionItems(uri, "select p."); !ok || len(items) != 1 || items[0].Label != "amount" {
+		t.Errorf("expected source columns for p, got %v", items)
+	}
+}
+
+func TestPackageAndVersionColumns(t *testing.T) {
+	root, err := testutils.GetTestdataPath("versioned_project")
+	if err != nil {
+		t.Fatal(err)
+	}
+	state := NewState()
+	state.refreshDbtContext(root)
+
+	uri := "file://" + filepath.Join(root, "models/report.sql")
+	state.parseDocument(uri, `select s.*, c.customer_id, d.customer_key
+from {{ ref('orders') }} o
+join {{ ref('shared', 'orders') }} s on true
+join {{ ref('dim_customers', v=1) }} c on true
+join {{ ref('dim_customers') }} d on true`)
+
+	completions := map[string]string{
+		"select o.": "order_id",
+		"select s.": "package_order_id",
+		"select c.": "customer_id",
+		"select d.": "customer_key",
+	}
+	for text, expected := range completions {
+		items, ok := state.getColumnCompletionItems(uri, text)
+		if !ok || len(items) != 1 || items[0].Label != expected {
+			t.Errorf("%s: expected %s, got %v", text, expected, items)
+		}
+	}
+
+	expected := []lsp.ColumnLineage{}
+	for _, upstream := range []lsp.UpstreamColumn{
+		{Type: "model", Name: "orders", Column: "package_order_id", URI: "file://" + filepath.Join(root, "dbt_packages/shared/models/orders.sql")},
+		{Type: "model", Name: "dim_customers", Column: "customer_id", URI: "file://" + filepath.Join(root, "models/dim_customers_v1.sql")},
+		{Type: "model", Name: "dim_customers", Column: "customer_key", URI: "file://" + filepath.Join(root, "models/dim_customers_v2.sql")},
+	} {
+		expected = append(expected, lsp.ColumnLineage{Name: upstream.Column, Upstream: []lsp.UpstreamColumn{upstream}})
+	}
+	actual := state.documentColumnLineage(uri)
+	if len(actual) != len(expected) {
+		t.Fatalf("expected %d columns, got %v", len(expected), actual)
+	}
+	for i, column := range actual {
+		if column.Name != expected[i].Name || !reflect.DeepEqual(column.Upstream, expected[i].Upstream) {
+			t.Errorf("expected %v, got %v", expected[i], column)
+		}
+	}
+}
+
+func TestHoverColumnTable(t *testing.T) {
+	state := columnTestState()
+	uri := "file:///project/models/orders.sql"
+	state.parseDocument(uri, "select * from {{ ref('stg_orders') }}")
+
+	expected := "Staged orders\n\n" +
+		"| Column | Type | Description |\n| --- | --- | --- |\n" +
+		"| order_id | INTEGER | Primary key |\n" +
+		"| status | VARCHAR |  |\n"
+
+	response := state.Hover(1, uri, lsp.Position{Line: 0, Character: 24})
+	if response.Result.Contents != expected {
+		t.Fatalf("expected %q, got %q", expected, response.Result.Contents)
+	}
+}
@@ -0,0 +1,180 @@
+package analysis
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/j-clemons/dbt-language-server/analysis/parser"
+	"github.com/j-clemons/dbt-language-server/lsp"
+	diagnosticseverity "github.com/j-clemons/dbt-language-server/lsp/diagnosticSeverity"
+)
+
+const diagnosticSource = "dbt-language-server"
+
+// Namespaces from the dbt jinja context that look like package calls but
+// never resolve to a macro file.
+var builtinNamespaces = map[string]bool{
+	"adapter":    true,
+	"api":        true,
+	"builtins":   true,
+	"dbt":        true,
+	"exceptions": true,
+	"graph":      true,
+	"model":      true,
+	"modules":    true,
+	"target":     true,
+	"this":       true,
+}
+
+func newDiagnostic(token parser.Token, severity int, code string, message string) lsp.Diagnostic {
+	return lsp.Diagnostic{
+		Range:    tokenRange(token),
+		Message:  message,
+		Severity: severity,
+		Code:     code,
+		Source:   diagnosticSource,
+	}
+}
+
+// jinjaLocalNames collects names bound by {% set %} and {% for %} so calls
+// like {{ results.print_table() }} aren't reported as missing packages.
+func jinjaLocalNames(tokens []parser.TokenLL) map[string]bool {
+	names := make(map[string]bool)
+	for i := 0; i+2 < len(tokens); i++ {
+		if tokens[i].Token.Type != parser.JINJA_LBRACE {
+			continue
+		}
+		switch strings.ToLower(tokens[i+1].Token.Literal) {
+		case "set", "for":
+			names[tokens[i+2].Token.Literal] = true
+		}
+	}
+	return names
+}
+
+func varHasDefault(tokens []parser.TokenLL, varIdx int) bool {
+	return varIdx+2 < len(tokens) && tokens[varIdx+2].Token.Type == parser.COMMA
+}
+
+func (s *State) Diagnostics(uri string) []lsp.Diagnostic {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	s = s.project(uri)
+
+	diagnostics := []lsp.Diagnostic{}
+
+	doc, exists := s.Documents[uri]
+	if !exists || doc.Tokens == nil {
+		return diagnostics
+	}
+
+	tokens := doc.Tokens.Tokens()
+	localNames := jinjaLocalNames(tokens)
+
+	projectName := s.DbtContext.ProjectYaml.ProjectName.Value
+	current, inModel := s.modelAtPath(strings.TrimPrefix(uri, "file://"))
+	if inModel {
+		// the open text may have changed the model's own config
+		current = applySqlConfig(current, sqlConfigStrings(tokens))
+	}
+	for i := range tokens {
+		tokenLL := &tokens[i]
+		token := tokenLL.Token
+
+		reference, ok := referenceFromToken(tokenLL, strings.TrimPrefix(uri, "file://"), projectName)
+		if !ok {
+			continue
+		}
+		key := reference.Key
+
+		switch key.Type {
+		case parser.REF:
+			if target, ok := s.resolveModel(key); ok {
+				if inModel {
+					diagnostics = append(diagnostics, s.accessDiagnostics(token, key, target, current)...)
+				}
+				diagnostics = append(diagnostics, deprecationDiagnostics(token, key, target, time.Now())...)
+				continue
+			}
+			message := fmt.Sprintf("Model '%s' was not found in the project or installed packages", key.Name)
+			if model, ok := s.resolveModel(ReferenceKey{Type: key.Type, Package: key.Package, Name: key.Name}); ok && key.Version != "" {
+				message = fmt.Sprintf("Version '%s' of model '%s' was not found", key.Version, key.Name)
+				if len(model.Versions) == 0 {
+					message = fmt.Sprintf("Model '%s' is not versioned", key.Name)
+				}
+			} else if key.Package != "" {
+				message = fmt.Sprintf("Model '%s' was not found in package '%s'", key.Name, key.Package)
+			}
+			diagnostics = append(diagnostics, newDiagnostic(
+				token,
+				diagnosticseverity.Error,
+				"unresolved-ref",
+				message,
+			))
+		case parser.SOURCE:
+			if _, ok := s.DbtContext.SourceDetailMap[key.Name]; !ok {
+				diagnostics = append(diagnostics, newDiagnostic(
+					token,
+					diagnosticseverity.Error,
+					"unresolved-source",
+					fmt.Sprintf("Source '%s' was not found", key.Name),
+				))
+			}
+		case parser.SOURCE_TABLE:
+			source, ok := s.DbtContext.SourceDetailMap[key.Package]
+			if !ok {
+				continue
+			}
+			if _, ok := source.Tables[key.Name]; !ok {
+				diagnostics = append(diagnostics, newDiagnostic(
+					token,
+					diagnosticseverity.Error,
+					"unresolved-source",
+					fmt.Sprintf("Table '%s' was not found in source '%s'", key.Name, key.Package),
+				))
+			}
+		case parser.VAR:
+			if _, ok := s.DbtContext.VariableDetailMap[key.Name]; ok || varHasDefault(tokens, i) {
+				continue
+			}
+			diagnostics = append(diagnostics, newDiagnostic(
+				token,
+				diagnosticseverity.Warning,
+				"unresolved-var",
+				fmt.Sprintf("Variable '%s' is not defined in dbt_project.yml and has no default", key.Name),
+			))
+		case parser.MACRO:
+			// unqualified calls may be builtins or adapter macros, so only
+			// package-qualified calls are checked
+			if !reference.Qualified || builtinNamespaces[key.Package] || localNames[key.Package] {
+				continue
+			}
+			packageMacros, ok := s.DbtContext.MacroDetailMap[Package(key.Package)]
+			if !ok {
+				diagnostics = append(diagnostics, newDiagnostic(
+					tokenLL.PrevToken.PrevToken.Token,
+					diagnosticseverity.Error,
+					"unresolved-macro",
+					fmt.Sprintf("Package '%s' is not installed", key.Package),
+				))
+				continue
+			}
+			if _, ok := packageMacros[key.Name]; !ok {
+				diagnostics = append(diagnostics, newDiagnostic(
+					token,
+					diagnosticseverity.Error,
+					"unresolved-macro",
+					fmt.Sprintf("Macro '%s' was not found in package '%s'", key.Name, key.Package),
+				))
+			}
+		}
+	}
+
+	if doc.Yaml != nil {
+		diagnostics = append(diagnostics, s.yamlDiagnostics(doc)...)
+	}
+	diagnostics = append(diagnostics, s.columnDiagnostics(uri)...)
+
+	return diagnostics
+}
@@ -0,0 +1,107 @@
+package analysis
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/j-clemons/dbt-language-server/lsp"
+	diagnosticseverity "github.com/j-clemons/dbt-language-server/lsp/diagnosticSeverity"
+	"github.com/j-clemons/dbt-language-server/testutils"
+)
+
+func TestDiagnostics(t *testing.T) {
+	testdataRoot, err := testutils.GetTestdataPath("jaffle_shop_duckdb")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	state := NewState()
+	state.refreshDbtContext(testdataRoot)
+
+	uri := "file:///scratch/models/diagnostics.sql"
+	text := `{% set results = run_query('select 1') %}
+select
+    {{ var('jaffle_string') }},
+    {{ var('missing_var') }},
+    {{ var('missing_with_default', 1) }},
+    {{ jaffle_package.add_values('a', 'b') }},
+    {{ jaffle_package.missing_macro('a') }},
+    {{ jaffle_shop.full_name('a', 'b') }},
+    {{ jaffle_shop.missing_root_macro('a') }},
+    {{ unqualified_missing('a') }},
+    {{ dbt_utils.star('a') }},
+    {{ adapter.dispatch('a') }},
+    {{ results.print_table() }}
+from {{ ref('stg_orders') }}
+join {{ ref('missing_model') }}
+join {{ source('jaffle_shop', 'orders') }}
+join {{ source('jaffle_shop', 'missing_table') }}
+join {{ source('missing_source', 'orders') }}`
+	state.parseDocument(uri, text)
+
+	expected := []lsp.Diagnostic{
+		{
+			Range:    lsp.Range{Start: lsp.Position{Line: 3, Character: 12}, End: lsp.Position{Line: 3, Character: 23}},
+			Message:  "Variable 'missing_var' is not defined in dbt_project.yml and has no default",
+			Severity: diagnosticseverity.Warning,
+			Code:     "unresolved-var",
+			Source:   diagnosticSource,
+		},
+		{
+			Range:    lsp.Range{Start: lsp.Position{Line: 6, Character: 22}, End: lsp.Position{Line: 6, Character: 35}},
+			Message:  "Macro 'missing_macro' was not found in package 'jaffle_package'",
+			Severity: diagnosticseverity.Error,
+			Code:     "unresolved-macro",
+			Source:   diagnosticSource,
+		},
+		{
+			Range:    lsp.Range{Start: lsp.Position{Line: 8, Character: 19}, End: lsp.Position{Line: 8, Character: 37}},
+			Message:  "Macro 'missing_root_macro' was not found in package 'jaffle_shop'",
+			Severity: diagnosticseverity.Error,
+			Code:     "unresolved-macro",
+			Source:   diagnosticSource,
+		},
+		{
+			Range:    lsp.Range{Start: lsp.Position{Line: 10, Character: 7}, End: lsp.Position{Line: 10, Character: 16}},
+			Message:  "Package 'dbt_utils' is not installed",
+			Severity: diagnosticseverity.Error,
+			Code:     "unresolved-macro",
+			Source:   diagnosticSource,
+		},
+		{
+			Range:    lsp.Range{Start: lsp.Position{Line: 14, Character: 13}, End: lsp.Position{Line: 14, Character: 26}},
+			Message:  "Model 'missing_model' was not found in the project or installed packages",
+			Severity: diagnosticseverity.Error,
+			Code:     "unresolved-ref",
+			Source:   diagnosticSource,
+		},
+		{
+			Range:    lsp.Range{Start: lsp.Position{Line: 16, Character: 31}, End: lsp.Position{Line: 16, Character: 44}},
+			Message:  "Table 'missing_table' was not found in source 'jaffle_shop'",
+			Severity: diagnosticseverity.Error,
+			Code:     "unresolved-source",
+			Source:   diagnosticSource,
+		},
+		{
+			Range:    lsp.Range{Start: lsp.Position{Line: 17, Character: 16}, End: lsp.Position{Line: 17, Character: 30}},
+			Message:  "Source 'missing_source' was not found",
+			Severity: diagnosticseverity.Error,
+			Code:     "unresolved-source",
+			Source:   diagnosticSource,
+		},
+	}
+
+	actual := state.Diagnostics(uri)
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected %v,\n\ngot %v", expected, actual)
+	}
+}
+
+func TestDiagnosticsUnknownDocument(t *testing.T) {
+	state := NewState()
+
+	actual := state.Diagnostics("file:///not/open.sql")
+	if actual == nil || len(actual) != 0 {
+		t.Fatalf("expected an empty diagnostics slice, got %v", actual)
+	}
+}
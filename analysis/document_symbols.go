@@ -0,0 +1,375 @@
+package analysis
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/j-clemons/dbt-language-server/analysis/parser"
+	"github.com/j-clemons/dbt-language-server/lsp"
+	"github.com/j-clemons/dbt-language-server/lsp/symbolKind"
+)
+
+var jinjaBlockEnds = map[string]string{
+	"endmacro":    "macro",
+	"endif":       "if",
+	"endfor":      "for",
+	"endset":      "set",
+	"endtest":     "test",
+	"endsnapshot": "snapshot",
+	"endcall":     "call",
+	"enddocs":     "docs",
+}
+
+type jinjaTag struct {
+	keyword string
+	start   int // index of {%
+	end     int // index of %}
+}
+
+// readJinjaTag reads the {% ... %} tag opening at tokens[i].
+func readJinjaTag(tokens []parser.TokenLL, i int) (jinjaTag, bool) {
+	j := i + 1
+	if j < len(tokens) && tokens[j].Token.Type == parser.MINUS {
+		j++
+	}
+	if j >= len(tokens) {
+		return jinjaTag{}, false
+	}
+
+	tag := jinjaTag{
+		keyword: strings.ToLower(tokens[j].Token.Literal),
+		start:   i,
+		end:     len(tokens) - 1,
+	}
+	for k := j; k < len(tokens); k++ {
+		if tokens[k].Token.Type == parser.JINJA_RBRACE {
+			tag.end = k
+			break
+		}
+	}
+	return tag, true
+}
+
+// tagText returns the source text between the tag delimiters, e.g.
+// "for payment_method in payment_methods" for a for loop.
+func tagText(lines []string, tokens []parser.TokenLL, tag jinjaTag) string {
+	start := tokens[tag.start].Token
+	end := tokens[tag.end].Token
+	if start.Line == end.Line && start.Line < len(lines) && end.Column <= len(lines[start.Line]) {
+		text := lines[start.Line][start.Column+len(start.Literal) : end.Column]
+		return strings.TrimSpace(strings.Trim(strings.TrimSpace(text), "-"))
+	}
+
+	literals := []string{}
+	for k := tag.start + 1; k < tag.end; k++ {
+		literals = append(literals, tokens[k].Token.Literal)
+	}
+	return strings.TrimSpace(strings.Trim(strings.Join(literals, " "), "-"))
+}
+
+func tokenSpan(start parser.Token, end parser.Token) lsp.Range {
+	return lsp.Range{
+		Start: tokenRange(start).Start,
+		End:   tokenRange(end).End,
+	}
+}
+
+func newDocumentSymbol(name string, detail string, kind int, rng lsp.Range, selection lsp.Range) lsp.DocumentSymbol {
+	return lsp.DocumentSymbol{
+		Name:           name,
+		Detail:         detail,
+		Kind:           kind,
+		Range:          rng,
+		SelectionRange: selection,
+	}
+}
+
+func jinjaSymbols(lines []string, tokens []parser.TokenLL) []lsp.DocumentSymbol {
+	symbols := []lsp.DocumentSymbol{}
+
+	type openBlock struct {
+		tag       jinjaTag
+		name      string
+		kind      int
+		selection lsp.Range
+	}
+	stack := []openBlock{}
+
+	for i := 0; i < len(tokens); i++ {
+		if tokens[i].Token.Type != parser.JINJA_LBRACE {
+			continue
+		}
+		tag, ok := readJinjaTag(tokens, i)
+		if !ok {
+			continue
+		}
+
+		if opener, isEnd := jinjaBlockEnds[tag.keyword]; isEnd {
+			for k := len(stack) - 1; k >= 0; k-- {
+				if stack[k].tag.keyword != opener {
+					continue
+				}
+				block := stack[k]
+				symbols = append(symbols, newDocumentSymbol(
+					block.name,
+					block.tag.keyword,
+					block.kind,
+					tokenSpan(tokens[block.tag.start].Token, tokens[tag.end].Token),
+					block.selection,
+				))
+				stack = stack[:k]
+				break
+			}
+			i = tag.end
+			continue
+		}
+
+		selection := tokenSpan(tokens[tag.start].Token, tokens[tag.end].Token)
+		nameIdx := tag.start + 2
+		if tokens[tag.start+1].Token.Type == parser.MINUS {
+			nameIdx++
+		}
+
+		switch tag.keyword {
+		case "macro", "test", "snapshot", "docs":
+			name := tag.keyword
+			if nameIdx < tag.end {
+				name = tokens[nameIdx].Token.Literal
+				selection = tokenRange(tokens[nameIdx].Token)
+			}
+			stack = append(stack, openBlock{tag: tag, name: name, kind: symbolKind.Function, selection: selection})
+		case "if", "for", "call":
+			stack = append(stack, openBlock{tag: tag, name: tagText(lines, tokens, tag), kind: symbolKind.Namespace, selection: selection})
+		case "set":
+			isBlockSet := true
+			for k := tag.start; k < tag.end; k++ {
+				if tokens[k].Token.Type == parser.EQUAL {
+					isBlockSet = false
+					break
+				}
+			}
+			name := tag.keyword
+			if nameIdx < tag.end {
+				name = "set " + tokens[nameIdx].Token.Literal
+			}
+			if isBlockSet {
+				stack = append(stack, openBlock{tag: tag, name: name, kind: symbolKind.Variable, selection: selection})
+			} else {
+				symbols = append(symbols, newDocumentSymbol(
+					name,
+					tag.keyword,
+					symbolKind.Variable,
+					tokenSpan(tokens[tag.start].Token, tokens[tag.end].Token),
+					selection,
+				))
+			}
+		}
+		i = tag.end
+	}
+
+	return symbols
+}
+
+func configSymbols(tokens []parser.TokenLL) []lsp.DocumentSymbol {
+	symbols := []lsp.DocumentSymbol{}
+	for i := 0; i+1 < len(tokens); i++ {
+		if tokens[i].Token.Type != parser.DB_LBRACE || tokens[i+1].Token.Type != parser.CONFIG {
+			continue
+		}
+		end := len(tokens) - 1
+		for k := i + 1; k < len(tokens); k++ {
+			if tokens[k].Token.Type == parser.DB_RBRACE {
+				end = k
+				break
+			}
+		}
+		symbols = append(symbols, newDocumentSymbol(
+			"config",
+			"",
+			symbolKind.Object,
+			tokenSpan(tokens[i].Token, tokens[end].Token),
+			tokenRange(tokens[i+1].Token),
+		))
+		i = end
+	}
+	return symbols
+}
+
+// matchingParen returns the index of the paren closing the one at tokens[i].
+func matchingParen(tokens []parser.TokenLL, i int) int {
+	depth := 0
+	for k := i; k < len(tokens); k++ {
+		switch tokens[k].Token.Type {
+		case parser.LPAREN:
+			depth++
+		case parser.RPAREN:
+			depth--
+			if depth == 0 {
+				return k
+			}
+		}
+	}
+	return len(tokens) - 1
+}
+
+// cteSymbols builds a symbol per CTE and returns the index of the last
+// token of the final CTE body, or -1 when the document has no CTEs.
+func cteSymbols(tokens []parser.TokenLL, cteTokens []parser.Token) ([]lsp.DocumentSymbol, int) {
+	symbols := []lsp.DocumentSymbol{}
+	lastCteEnd := -1
+
+	isCte := make(map[parser.Token]bool)
+	for _, token := range cteTokens {
+		isCte[token] = true
+	}
+
+	for i := range tokens {
+		token := tokens[i].Token
+		if !isCte[token] {
+			continue
+		}
+
+		open := -1
+		for k := i + 1; k < len(tokens) && k <= i+2; k++ {
+			if tokens[k].Token.Type == parser.LPAREN {
+				open = k
+				break
+			}
+		}
+		if open == -1 {
+			continue
+		}
+
+		end := matchingParen(tokens, open)
+		symbols = append(symbols, newDocumentSymbol(
+			token.Literal,
+			"cte",
+			symbolKind.Struct,
+			tokenSpan(token, tokens[end].Token),
+			tokenRange(token),
+		))
+		if end > lastCteEnd {
+			lastCteEnd = end
+		}
+	}
+
+	return symbols, lastCteEnd
+}
+
+func finalSelectSymbol(tokens []parser.TokenLL, after int) (lsp.DocumentSymbol, bool) {
+	depth := 0
+	for i := 0; i < len(tokens); i++ {
+		switch tokens[i].Token.Type {
+		case parser.LPAREN:
+			depth++
+		case parser.RPAREN:
+			depth--
+		default:
+			if i > after && depth == 0 && strings.ToLower(tokens[i].Token.Literal) == "select" {
+				last := len(tokens) - 1
+				return newDocumentSymbol(
+					"select",
+					"final select",
+					symbolKind.Module,
+					tokenSpan(tokens[i].Token, tokens[last].Token),
+					tokenRange(tokens[i].Token),
+				), true
+			}
+		}
+	}
+	return lsp.DocumentSymbol{}, false
+}
+
+func rangeContains(outer lsp.Range, inner lsp.Range) bool {
+	return !positionBefore(inner.Start, outer.Start) && !positionBefore(outer.End, inner.End)
+}
+
+func positionBefore(a lsp.Position, b lsp.Position) bool {
+	return a.Line < b.Line || (a.Line == b.Line && a.Character < b.Character)
+}
+
+// nestDocumentSymbols turns a flat symbol list into a tree based on range
+// containment.
+func nestDocumentSymbols(symbols []lsp.DocumentSymbol) []lsp.DocumentSymbol {
+	sort.SliceStable(symbols, func(i, j int) bool {
+		if symbols[i].Range.Start != symbols[j].Range.Start {
+			return positionBefore(symbols[i].Range.Start, symbols[j].Range.Start)
+		}
+		return positionBefore(symbols[j].Range.End, symbols[i].Range.End)
+	})
+
+	type node struct {
+		symbol   lsp.DocumentSymbol
+		children []*node
+	}
+
+	roots := []*node{}
+	stack := []*node{}
+	for _, symbol := range symbols {
+		n := &node{symbol: symbol}
+		for len(stack) > 0 && !rangeContains(stack[len(stack)-1].symbol.Range, symbol.Range) {
+			stack = stack[:len(stack)-1]
+		}
+		if len(stack) == 0 {
+			roots = append(roots, n)
+		} else {
+			parent := stack[len(stack)-1]
+			parent.children = append(parent.children, n)
+		}
+		stack = append(stack, n)
+	}
+
+	var build func(nodes []*node) []lsp.DocumentSymbol
+	build = func(nodes []*node) []lsp.DocumentSymbol {
+		result := []lsp.DocumentSymbol{}
+		for _, n := range nodes {
+			symbol := n.symbol
+			if len(n.children) > 0 {
+				symbol.Children = build(n.children)
+			}
+			result = append(result, symbol)
+		}
+		return result
+	}
+
+	return build(roots)
+}
+
+func (s *State) DocumentSymbol(id lsp.RequestID, uri string) lsp.DocumentSymbolResponse {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	s = s.project(uri)
+
+	response := lsp.DocumentSymbolResponse{
+		Response: lsp.Response{
+			RPC: "2.0",
+			ID:  id,
+		},
+		Result: []lsp.DocumentSymbol{},
+	}
+
+	doc, exists := s.Documents[uri]
+	if !exists || doc.Tokens == nil {
+		return response
+	}
+
+	tokens := doc.Tokens.Tokens()
+	if len(tokens) == 0 {
+		return response
+	}
+
+	symbols := []lsp.DocumentSymbol{}
+	symbols = append(symbols, configSymbols(tokens)...)
+
+	ctes, lastCteEnd := cteSymbols(tokens, doc.CTETokens)
+	symbols = append(symbols, ctes...)
+
+	if finalSelect, ok := finalSelectSymbol(tokens, lastCteEnd); ok {
+		symbols = append(symbols, finalSelect)
+	}
+
+	symbols = append(symbols, jinjaSymbols(strings.Split(doc.Text, "\n"), tokens)...)
+
+	response.Result = nestDocumentSymbols(symbols)
+	return response
+}
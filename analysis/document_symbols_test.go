@@ -0,0 +1,172 @@
+package analysis
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/j-clemons/dbt-language-server/lsp"
+	"github.com/j-clemons/dbt-language-server/lsp/symbolKind"
+)
+
+func TestDocumentSymbol(t *testing.T) {
+	state := NewState()
+	state.DbtContext.Dialect = "duckdb"
+
+	uri := "file:///scratch/models/orders.sql"
+	state.parseDocument(uri, `{{ config(materialized='table') }}
+{% set payment_methods = ['credit_card', 'coupon'] %}
+
+with payments as (
+    select * from {{ ref('stg_payments') }}
+),
+
+order_payments as (
+    select
+        order_id,
+        {% for payment_method in payment_methods -%}
+        sum(amount) as {{ payment_method }}_amount,
+        {% endfor -%}
+        sum(amount) as total_amount
+    from payments
+    group by order_id
+)
+
+select * from order_payments
+{% if is_incremental() %}
+where 1 = 1
+{% endif %}`)
+
+	span := func(startLine, startChar, endLine, endChar int) lsp.Range {
+		return lsp.Range{
+			Start: lsp.Position{Line: startLine, Character: startChar},
+			End:   lsp.Position{Line: endLine, Character: endChar},
+		}
+	}
+
+	expected := []lsp.DocumentSymbol{
+		{
+			Name:           "config",
+			Kind:           symbolKind.Object,
+			Range:          span(0, 0, 0, 34),
+			SelectionRange: span(0, 3, 0, 9),
+		},
+		{
+			Name:           "set payment_methods",
+			Detail:         "set",
+			Kind:           symbolKind.Variable,
+			Range:          span(1, 0, 1, 53),
+			SelectionRange: span(1, 0, 1, 53),
+		},
+		{
+			Name:           "payments",
+			Detail:         "cte",
+			Kind:           symbolKind.Struct,
+			Range:          span(3, 5, 5, 1),
+			SelectionRange: span(3, 5, 3, 13),
+		},
+		{
+			Name:           "order_payments",
+			Detail:         "cte",
+			Kind:           symbolKind.Struct,
+			Range:          span(7, 0, 16, 1),
+			SelectionRange: span(7, 0, 7, 14),
+			Children: []lsp.DocumentSymbol{
+				{
+					Name:           "for payment_method in payment_methods",
+					Detail:         "for",
+					Kind:           symbolKind.Namespace,
+					Range:          span(10, 8, 12, 21),
+					SelectionRange: span(10, 8, 10, 52),
+				},
+			},
+		},
+		{
+			Name:           "select",
+			Detail:         "final select",
+			Kind:           symbolKind.Module,
+			Range:          span(18, 0, 21, 11),
+			SelectionRange: span(18, 0, 18, 6),
+			Children: []lsp.DocumentSymbol{
+				{
+					Name:           "if is_incremental()",
+					Detail:         "if",
+					Kind:           symbolKind.Namespace,
+					Range:          span(19, 0, 21, 11),
+					SelectionRange: span(19, 0, 19, 25),
+				},
+			},
+		},
+	}
+
+	actual := state.DocumentSymbol(1, uri).Result
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected %+v,\n\ngot %+v", expected, actual)
+	}
+}
+
+func TestDocumentSymbolMacroFile(t *testing.T) {
+	state := NewState()
+
+	uri := "file:///scratch/macros/macros.sql"
+	state.parseDocument(uri, `{% macro full_name(first_name, last_name) %}
+    {% if first_name %}
+    {{ first_name }} {{ last_name }}
+    {% endif %}
+{% endmacro %}
+
+{%- macro times_five(int_value) -%}
+    {{ int_value * 5 }}
+{%- endmacro -%}`)
+
+	actual := state.DocumentSymbol(1, uri).Result
+
+	if len(actual) != 2 {
+		t.Fatalf("expected 2 macro symbols, got %+v", actual)
+	}
+
+	if actual[0].Name != "full_name" || actual[0].Kind != symbolKind.Function {
+		t.Errorf("expected full_name macro symbol, got %+v", actual[0])
+	}
+	if len(actual[0].Children) != 1 || actual[0].Children[0].Name != "if first_name" {
+		t.Errorf("expected nested if block in full_name, got %+v", actual[0].Children)
+	}
+
+	if actual[1].Name != "times_five" || actual[1].Range.End != (lsp.Position{Line: 8, Character: 16}) {
+		t.Errorf("expected times_five macro ending at 8:16, got %+v", actual[1])
+	}
+}
+
+func TestDocumentSymbolSameNamedCtes(t *testing.T) {
+	state := NewState()
+	state.DbtContext.Dialect = "duckdb"
+
+	uri := "file:///scratch/models/pairs.sql"
+	state.parseDocument(uri, `select * from (
+    with base as (select 1 as a)
+    select * from base
+) x
+join (
+    with base as (select 2 as b)
+    select * from base
+) y on true`)
+
+	ctes := []lsp.Range{}
+	var collect func(symbols []lsp.DocumentSymbol)
+	collect = func(symbols []lsp.DocumentSymbol) {
+		for _, symbol := range symbols {
+			if symbol.Detail == "cte" && symbol.Name == "base" {
+				ctes = append(ctes, symbol.SelectionRange)
+			}
+			collect(symbol.Children)
+		}
+	}
+	collect(state.DocumentSymbol(1, uri).Result)
+
+	expected := []lsp.Range{
+		{Start: lsp.Position{Line: 1, Character: 9}, End: lsp.Position{Line: 1, Character: 13}},
+		{Start: lsp.Position{Line: 5, Character: 9}, End: lsp.Position{Line: 5, Character: 13}},
+	}
+	if !reflect.DeepEqual(ctes, expected) {
+		t.Fatalf("expected a symbol for each base CTE at %v, got %v", expected, ctes)
+	}
+}
@@ -2,6 +2,7 @@ package fusion
 
 import (
 	"bufio"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -43,34 +44,71 @@ type Info struct {
 	Ts           string
 }
 
-func publishDiagnostics(writer io.Writer, uri string, diagnostics []lsp.Diagnostic) {
-	notification := lsp.DiagnosticsNotification{
-		Notification: lsp.Notification{
-			RPC:    "2.0",
-			Method: "textDocument/publishDiagnostics",
-		},
-		Params: lsp.PublishDiagnosticsParams{
-			URI:         uri,
-			Diagnostics: diagnostics,
-		},
+func publishDiagnostics(s *analysis.State, writer io.Writer, uri string, diagnostics []lsp.Diagnostic) {
+	util.WriteResponse(writer, s.EncodePositions(uri, lsp.NewDiagnosticsNotification(uri, diagnostics)))
+}
+
+// running holds the compile in progress for each document.
+var running = struct {
+	sync.Mutex
+	compiles map[string]*compile
+}{compiles: map[string]*compile{}}
+
+type compile struct {
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// supersede cancels the compile in progress for uri, waits for it to stop,
+// and returns the context of the new compile with a func to call when it's
+// done. Compiles of a document are serialized, so a cancelled one can't
+// publish its diagnostics over a newer one's.
+func supersede(ctx context.Context, uri string) (context.Context, func()) {
+	ctx, cancel := context.WithCancel(ctx)
+	c := &compile{cancel: cancel, done: make(chan struct{})}
+
+	running.Lock()
+	previous := running.compiles[uri]
+	running.compiles[uri] = c
+	running.Unlock()
+
+	if previous != nil {
+		previous.cancel()
+		<-previous.done
 	}
 
-	util.WriteResponse(writer, notification)
+	return ctx, func() {
+		cancel()
+		running.Lock()
+		if running.compiles[uri] == c {
+			delete(running.compiles, uri)
+		}
+		running.Unlock()
+		close(c.done)
+	}
 }
 
-func FusionCompile(s *analysis.State, uri string, logger *log.Logger, writer io.Writer) {
+// FusionCompile compiles the model at uri and publishes its diagnostics. A
+// later compile of the same document cancels this one, as does ctx.
+func FusionCompile(ctx context.Context, s *analysis.State, uri string, logger *log.Logger, writer io.Writer) {
 	if !s.IsFusionEnabled() {
 		return
 	}
+	ctx, done := supersede(ctx, uri)
+	defer done()
+	if ctx.Err() != nil {
+		return
+	}
 	selector := dbtModelSelectionFromUri(uri)
 
-	projectName := s.DbtContext.ProjectYaml.ProjectName.Value
+	projectName := s.ProjectName(uri)
 	fusionArtifactPath, err := getFusionArtifactPath(projectName)
 	if err != nil {
 		logger.Printf("Failed to get fusion artifact path: %v", err)
 		return
 	}
-	cmd := exec.Command(
+	cmd := exec.CommandContext(
+		ctx,
 		s.FusionPath,
 		"compile",
 		"-q",
@@ -81,6 +119,8 @@ func FusionCompile(s *analysis.State, uri string, logger *log.Logger, writer io.
 		"--log-path", filepath.Join(fusionArtifactPath, "log"),
 		"--select", selector,
 	)
+	// run in the document's project, which needn't be the server's directory
+	cmd.Dir = s.ProjectRoot(uri)
 	logger.Printf("Running: %v\n", cmd.Args)
 
 	stdout, err := cmd.StdoutPipe()
@@ -95,17 +135,22 @@ func FusionCompile(s *analysis.State, uri string, logger *log.Logger, writer io.
 
 	if err := cmd.Start(); err != nil {
 		logger.Println(err)
+		return
 	}
 
 	diagnosticsChan := make(chan lsp.Diagnostic, 100)
 	diagnostics := []lsp.Diagnostic{}
 
 	var wg sync.WaitGroup
+	collected := make(chan struct{})
 
 	go func() {
+		defer close(collected)
 		for diagnostic := range diagnosticsChan {
 			diagnostics = append(diagnostics, diagnostic)
-			publishDiagnostics(writer, uri, diagnostics)
+			if ctx.Err() == nil {
+				publishDiagnostics(s, writer, uri, diagnostics)
+			}
 		}
 	}()
 
@@ -130,7 +175,12 @@ func FusionCompile(s *analysis.State, uri string, logger *log.Logger, writer io.
 		logger.Printf("Command failed: %v", err)
 	}
 
-	publishDiagnostics(writer, uri, diagnostics)
+	<-collected
+	if ctx.Err() != nil {
+		logger.Printf("Compile of %s was superseded", uri)
+		return
+	}
+	publishDiagnostics(s, writer, uri, diagnostics)
 }
 
 func processStream(stream io.Reader, uri string, logger *log.Logger, diagnosticsChan chan lsp.Diagnostic, streamName string) {
@@ -1,7 +1,9 @@
 package fusion
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	"github.com/j-clemons/dbt-language-server/lsp"
 )
@@ -64,3 +66,41 @@ func TestParseFusionLogMsg(t *testing.T) {
 		}
 	}
 }
+
+func TestSupersedeCompile(t *testing.T) {
+	uri := "file:///models/orders.sql"
+	first, firstDone := supersede(context.Background(), uri)
+
+	started := make(chan context.Context)
+	var secondDone func()
+	go func() {
+		var second context.Context
+		second, secondDone = supersede(context.Background(), uri)
+		started <- second
+	}()
+
+	// the second compile cancels the first, and waits for it to stop
+	<-first.Done()
+	select {
+	case <-started:
+		t.Fatal("expected the second compile to wait for the first")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	firstDone()
+	select {
+	case second := <-started:
+		defer secondDone()
+		if second.Err() != nil {
+			t.Fatalf("expected the second compile to run, got %v", second.Err())
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the second compile")
+	}
+
+	other, otherDone := supersede(context.Background(), "file:///models/customers.sql")
+	defer otherDone()
+	if other.Err() != nil {
+		t.Fatal("expected compiles of other documents to be independent")
+	}
+}
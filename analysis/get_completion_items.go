@@ -2,6 +2,7 @@ package analysis
 
 import (
 	"fmt"
+	"path/filepath"
 	"regexp"
 	"strings"
 
@@ -29,6 +30,32 @@ func getRefCompletionItems(modelMap map[string]ModelDetails, suffix string) []ls
 	return items
 }
 
+// getVersionCompletionItems completes the v= argument of a ref() to a
+// versioned model.
+func getVersionCompletionItems(model ModelDetails) []lsp.CompletionItem {
+	items := make([]lsp.CompletionItem, 0, len(model.Versions))
+
+	for v, version := range model.Versions {
+		detail := filepath.Base(version.URI)
+		if v == model.Version {
+			detail += " (latest)"
+		}
+		items = append(
+			items,
+			lsp.CompletionItem{
+				Label:         v,
+				Detail:        detail,
+				Documentation: version.Description,
+				Kind:          completionKind.Value,
+				InsertText:    v,
+				SortText:      v,
+			},
+		)
+	}
+
+	return items
+}
+
 func reverseRefPrefix(str string) string {
 	var result string
 	for _, v := range str {
@@ -0,0 +1,157 @@
+package analysis
+
+import (
+	"encoding/csv"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/j-clemons/dbt-language-server/analysis/parser"
+	"github.com/j-clemons/dbt-language-server/util"
+)
+
+// columnInference infers model columns in dependency order, so a
+// `select *` from a ref can use the upstream model's inferred columns.
+type columnInference struct {
+	s *State
+	// visited holds the models seen, by file and version
+	visited map[string]bool
+	// code holds model SQL already read, such as from the manifest, by path
+	code map[string]string
+}
+
+// inferModelColumns sets InferredColumns on every model, model version and
+// seed, in the root project and installed packages. SQL is taken from code
+// when it holds the model and the model isn't open.
+func (s *State) inferModelColumns(code map[string]string) {
+	inference := &columnInference{s: s, visited: make(map[string]bool), code: code}
+	for name := range s.DbtContext.ModelDetailMap {
+		inference.infer(ReferenceKey{Type: parser.REF, Name: name})
+	}
+	for pkg, models := range s.DbtContext.PackageModelMap {
+		for name, model := range models {
+			inference.infer(ReferenceKey{Type: parser.REF, Package: string(pkg), Name: name})
+			for version := range model.Versions {
+				inference.infer(ReferenceKey{Type: parser.REF, Package: string(pkg), Name: name, Version: version})
+			}
+		}
+	}
+}
+
+func (c *columnInference) infer(key ReferenceKey) {
+	model, ok := c.s.resolveModel(key)
+	// snapshots add metadata columns their SQL doesn't select
+	if !ok || model.Snapshot {
+		return
+	}
+	// marking before recursing also stops ref cycles
+	id := model.URI + "@" + model.Version
+	if c.visited[id] {
+		return
+	}
+	c.visited[id] = true
+
+	var columns []Column
+	switch filepath.Ext(model.URI) {
+	case ".csv":
+		columns = seedColumns(model.URI)
+	case ".sql":
+		columns = c.sqlColumns(model.URI)
+	}
+	if columns == nil {
+		return
+	}
+	c.s.setInferredColumns(key.Name, model, columns)
+}
+
+// setInferredColumns stores columns on model wherever it's held: under its
+// package, under its name when the root project doesn't shadow it, and in
+// the versions of a versioned model.
+func (s *State) setInferredColumns(name string, model ModelDetails, columns []Column) {
+	update := func(models map[string]ModelDetails) {
+		entry, ok := models[name]
+		if !ok {
+			return
+		}
+		if sameModel(entry, model) {
+			entry.InferredColumns = columns
+			models[name] = entry
+		}
+		if version, ok := entry.Versions[model.Version]; ok && model.Version != "" && sameModel(version, model) {
+			version.InferredColumns = columns
+			entry.Versions[model.Version] = version
+		}
+	}
+	update(s.DbtContext.PackageModelMap[Package(model.ProjectName)])
+	update(s.DbtContext.ModelDetailMap)
+}
+
+// sqlColumns returns the output columns of the model at path, or nil if any
+// of them can't be named.
+func (c *columnInference) sqlColumns(path string) []Column {
+	text, err := c.text(path)
+	if err != nil {
+		return nil
+	}
+
+	query := parser.ParseQuery(parser.Parse(text, c.s.DbtContext.Dialect).CreateTokenIndex().Tokens())
+	if query == nil {
+		return nil
+	}
+
+	r := lineageResolver{s: c.s, infer: c.infer}
+	defs, complete := r.definitions(query, lineageScope{}, 0)
+	if !complete {
+		return nil
+	}
+
+	columns := []Column{}
+	seen := make(map[string]bool)
+	for _, def := range defs {
+		if seen[strings.ToLower(def.name)] {
+			continue
+		}
+		seen[strings.ToLower(def.name)] = true
+		columns = append(columns, Column{Name: def.name})
+	}
+	return columns
+}
+
+func (c *columnInference) text(path string) (string, error) {
+	if _, open := c.s.Documents["file://"+path]; !open {
+		if code, ok := c.code[path]; ok {
+			return code, nil
+		}
+	}
+	return c.s.documentText(path)
+}
+
+// documentText prefers the editor's copy of a file over the one on disk.
+func (s *State) documentText(path string) (string, error) {
+	if doc, open := s.Documents["file://"+path]; open {
+		return doc.Text, nil
+	}
+	return util.ReadFileContents(path)
+}
+
+func seedColumns(path string) []Column {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil
+	}
+	defer f.Close()
+
+	header, err := csv.NewReader(f).Read()
+	if err != nil {
+		return nil
+	}
+
+	columns := []Column{}
+	for _, name := range header {
+		name = strings.TrimSpace(strings.TrimPrefix(name, "\ufeff"))
+		if name != "" {
+			columns = append(columns, Column{Name: name})
+		}
+	}
+	return columns
+}
@@ -0,0 +1,72 @@
+package analysis
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/j-clemons/dbt-language-server/analysis/parser"
+)
+
+func TestInferModelColumns(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"raw_orders.csv": "id,customer_id,status\n1,2,placed\n",
+		"stg_orders.sql": `select id as order_id, customer_id, status from {{ ref('raw_orders') }}`,
+		"orders.sql": `with orders as (select * from {{ ref('stg_orders') }})
+select
+    {{ dbt_utils.star(from=ref('stg_orders'), except=['status'], prefix='o_') }},
+    orders.*,
+    count(*) over () as total
+from orders`,
+		"cataloged.sql":   `select * from {{ ref('stg_orders') }}`,
+		"from_source.sql": `select * from {{ source('raw', 'events') }}`,
+		"unnamed.sql":     `select order_id, count(*) from {{ ref('stg_orders') }} group by 1`,
+		"cycle_a.sql":     `select * from {{ ref('cycle_b') }}`,
+		"cycle_b.sql":     `select * from {{ ref('cycle_a') }}`,
+	}
+	state := NewState()
+	for name, contents := range files {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(contents), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		model := name[:len(name)-len(filepath.Ext(name))]
+		state.DbtContext.ModelDetailMap[model] = ModelDetails{URI: path}
+	}
+	cataloged := state.DbtContext.ModelDetailMap["cataloged"]
+	cataloged.Columns = []Column{{Name: "order_id", Type: "INTEGER"}}
+	state.DbtContext.ModelDetailMap["cataloged"] = cataloged
+
+	// the editor's unsaved text wins over the file on disk
+	state.parseDocument("file://"+filepath.Join(dir, "stg_orders.sql"), `select id as order_id, customer_id, status, 1 as is_open from {{ ref('raw_orders') }}`)
+
+	state.inferModelColumns(nil)
+
+	tests := map[string][]Column{
+		"raw_orders": columnsNamed("id", "customer_id", "status"),
+		"stg_orders": columnsNamed("order_id", "customer_id", "status", "is_open"),
+		"orders": columnsNamed(
+			"o_order_id", "o_customer_id", "o_is_open",
+			"order_id", "customer_id", "status", "is_open",
+			"total",
+		),
+		"cataloged":   columnsNamed("order_id", "customer_id", "status", "is_open"),
+		"from_source": nil,
+		"unnamed":     nil,
+		"cycle_a":     nil,
+		"cycle_b":     nil,
+	}
+	for model, expected := range tests {
+		actual := state.DbtContext.ModelDetailMap[model].InferredColumns
+		if !reflect.DeepEqual(actual, expected) {
+			t.Errorf("%s: expected %v, got %v", model, expected, actual)
+		}
+	}
+
+	// catalog columns take precedence over inferred ones
+	if columns := state.relationColumns(relation{Type: parser.REF, Name: "cataloged"}); len(columns) != 1 || columns[0].Type != "INTEGER" {
+		t.Errorf("expected catalog columns, got %v", columns)
+	}
+}
@@ -0,0 +1,216 @@
+package analysis
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/j-clemons/dbt-language-server/analysis/parser"
+	"github.com/j-clemons/dbt-language-server/lsp"
+	diagnosticseverity "github.com/j-clemons/dbt-language-server/lsp/diagnosticSeverity"
+	"github.com/j-clemons/dbt-language-server/util"
+)
+
+// sqlConfigStrings reads the string arguments of a model's
+// `{{ config(...) }}` block, e.g. access='private'.
+func sqlConfigStrings(tokens []parser.TokenLL) map[string]string {
+	config := make(map[string]string)
+	for i := 0; i+2 < len(tokens); i++ {
+		if tokens[i].Token.Type != parser.DB_LBRACE || tokens[i+1].Token.Type != parser.CONFIG || tokens[i+2].Token.Type != parser.LPAREN {
+			continue
+		}
+		end := matchingParen(tokens, i+2)
+		for k := i + 3; k+3 <= end; k++ {
+			quote := tokens[k+2].Token.Type
+			if tokens[k+1].Token.Type != parser.EQUAL || (quote != parser.SINGLE_QUOTE && quote != parser.DOUBLE_QUOTE) {
+				continue
+			}
+			value := ""
+			for v := k + 3; v < end && tokens[v].Token.Type != quote; v++ {
+				value += tokens[v].Token.Literal
+			}
+			config[tokens[k].Token.Literal] = value
+		}
+		break
+	}
+	return config
+}
+
+// modelConfigPath returns the folders, then the name, of the model at path
+// in the package p. These select the model's `models:` configs.
+func modelConfigPath(p ProjectDetails, path string) []string {
+	for _, modelPath := range p.DbtProjectYaml.ModelPaths.Value {
+		rel, err := filepath.Rel(filepath.Join(p.RootPath, modelPath), path)
+		if err == nil && !strings.HasPrefix(rel, "..") {
+			return strings.Split(strings.TrimSuffix(rel, filepath.Ext(rel)), string(filepath.Separator))
+		}
+	}
+	return nil
+}
+
+// projectModelConfig returns the access and group set for a model by the
+// `models:` configs of a package in dbt_project.yml. Configs of a folder
+// override those of its parents.
+func projectModelConfig(config any, configPath []string) (string, string) {
+	access, group := "", ""
+	for {
+		folder, ok := config.(map[string]any)
+		if !ok {
+			return access, group
+		}
+		for _, key := range []string{"access", "+access"} {
+			if value, ok := folder[key].(string); ok {
+				access = value
+			}
+		}
+		for _, key := range []string{"group", "+group"} {
+			if value, ok := folder[key].(string); ok {
+				group = value
+			}
+		}
+		if len(configPath) == 0 {
+			return access, group
+		}
+		config = folder[configPath[0]]
+		configPath = configPath[1:]
+	}
+}
+
+// applySqlConfig applies the access and group set in a model's SQL config,
+// which take precedence over properties files.
+func applySqlConfig(model ModelDetails, config map[string]string) ModelDetails {
+	if access, ok := config["access"]; ok {
+		model.Access = access
+	}
+	if group, ok := config["group"]; ok {
+		model.Group = group
+	}
+	return model
+}
+
+// applyModelGovernance resolves the access and group of each model in
+// modelMap, of the package p. The package's dbt_project.yml is applied
+// first, then the root project's configs for the package, the properties
+// files already read into modelMap and last the SQL config.
+func (s *State) applyModelGovernance(modelMap map[string]ModelDetails, p ProjectDetails) {
+	packageName := p.DbtProjectYaml.ProjectName.Value
+	govern := func(model ModelDetails) ModelDetails {
+		configPath := modelConfigPath(p, model.URI)
+		access, group := projectModelConfig(p.DbtProjectYaml.Models[packageName], configPath)
+		if p.RootPath != s.DbtContext.ProjectRoot {
+			rootAccess, rootGroup := projectModelConfig(s.DbtContext.ProjectYaml.Models[packageName], configPath)
+			if rootAccess != "" {
+				access = rootAccess
+			}
+			if rootGroup != "" {
+				group = rootGroup
+			}
+		}
+		if model.Access == "" {
+			model.Access = access
+		}
+		if model.Group == "" {
+			model.Group = group
+		}
+
+		if filepath.Ext(model.URI) != ".sql" {
+			return model
+		}
+		text, err := util.ReadFileContents(model.URI)
+		if err != nil || !strings.Contains(text, "config") {
+			return model
+		}
+		return applySqlConfig(model, sqlConfigStrings(parser.Parse(text, s.DbtContext.Dialect).CreateTokenIndex().Tokens()))
+	}
+
+	for name, model := range modelMap {
+		if len(model.Versions) == 0 {
+			modelMap[name] = govern(model)
+			continue
+		}
+		versions := model.Versions
+		for v, version := range versions {
+			versions[v] = govern(version)
+		}
+		// the entry under the name is the latest version
+		model = versions[model.Version]
+		model.Versions = versions
+		modelMap[name] = model
+	}
+}
+
+// modelAtPath finds the model, or model version, defined by the file at path.
+func (s *State) modelAtPath(path string) (ModelDetails, bool) {
+	for _, models := range s.DbtContext.PackageModelMap {
+		for _, model := range models {
+			if model.URI == path && !model.Snapshot {
+				return model, true
+			}
+			for _, version := range model.Versions {
+				if version.URI == path {
+					return version, true
+				}
+			}
+		}
+	}
+	return ModelDetails{}, false
+}
+
+// accessDiagnostics reports a ref from the model current to a private model
+// of another group, or to a protected model of another package.
+func (s *State) accessDiagnostics(token parser.Token, key ReferenceKey, target ModelDetails, current ModelDetails) []lsp.Diagnostic {
+	switch target.Access {
+	case "private":
+		if target.Group == current.Group {
+			return nil
+		}
+		message := fmt.Sprintf("Model '%s' is private to group '%s'", key.Name, target.Group)
+		if group, ok := s.DbtContext.SemanticDetailMap[GroupKind][target.Group]; ok && group.Owner != "" {
+			message += fmt.Sprintf(" (owner: %s)", group.Owner)
+		}
+		return []lsp.Diagnostic{newDiagnostic(token, diagnosticseverity.Warning, "private-ref", message)}
+	case "protected":
+		if target.ProjectName == current.ProjectName {
+			return nil
+		}
+		return []lsp.Diagnostic{newDiagnostic(
+			token,
+			diagnosticseverity.Warning,
+			"protected-ref",
+			fmt.Sprintf("Model '%s' is protected in package '%s' and can't be referenced from '%s'", key.Name, target.ProjectName, current.ProjectName),
+		)}
+	}
+	return nil
+}
+
+// deprecationDiagnostics reports a ref to a model, or model version, whose
+// deprecation_date has passed.
+func deprecationDiagnostics(token parser.Token, key ReferenceKey, target ModelDetails, now time.Time) []lsp.Diagnostic {
+	if target.DeprecationDate == "" {
+		return nil
+	}
+	deprecated, ok := parseDeprecationDate(target.DeprecationDate)
+	if !ok || deprecated.After(now) {
+		return nil
+	}
+	name := fmt.Sprintf("Model '%s'", key.Name)
+	if target.Version != "" {
+		name = fmt.Sprintf("Version %s of model '%s'", target.Version, key.Name)
+	}
+	return []lsp.Diagnostic{newDiagnostic(
+		token,
+		diagnosticseverity.Warning,
+		"deprecated-ref",
+		fmt.Sprintf("%s was deprecated on %s", name, target.DeprecationDate),
+	)}
+}
+
+func parseDeprecationDate(value string) (time.Time, bool) {
+	for _, layout := range []string{time.RFC3339, "2006-01-02 15:04:05", "2006-01-02T15:04:05", "2006-01-02"} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
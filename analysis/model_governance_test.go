@@ -0,0 +1,115 @@
+package analysis
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/j-clemons/dbt-language-server/analysis/parser"
+	"github.com/j-clemons/dbt-language-server/docs"
+	"github.com/j-clemons/dbt-language-server/testutils"
+)
+
+func TestSqlConfigStrings(t *testing.T) {
+	text := `{{ config(materialized="table", access='private', group='finance-team', tags=['a']) }}
+select 1`
+	tokens := parser.Parse(text, docs.Dialect("snowflake")).CreateTokenIndex().Tokens()
+
+	expected := map[string]string{
+		"materialized": "table",
+		"access":       "private",
+		"group":        "finance-team",
+	}
+	if actual := sqlConfigStrings(tokens); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+}
+
+func TestGovernanceDiagnostics(t *testing.T) {
+	root := testutils.CopyTestdata(t, "governed_project")
+	state := NewState()
+	state.refreshDbtContext(root)
+
+	// dbt_project.yml makes the finance folder private, which the properties
+	// and SQL configs override
+	governance := map[string][2]string{
+		"forecast": {"private", "finance"},
+		"budget":   {"protected", "finance"},
+		"summary":  {"public", "finance"},
+		"revenue":  {"private", "finance"},
+	}
+	for name, expected := range governance {
+		model := state.DbtContext.ModelDetailMap[name]
+		if actual := [2]string{model.Access, model.Group}; actual != expected {
+			t.Errorf("expected %s to have access and group %v, got %v", name, expected, actual)
+		}
+	}
+
+	governanceMessages := func(uri string) []string {
+		messages := []string{}
+		for _, d := range state.Diagnostics(uri) {
+			switch d.Code {
+			case "private-ref", "protected-ref", "deprecated-ref":
+				messages = append(messages, d.Message)
+			}
+		}
+		return messages
+	}
+
+	uri := "file://" + filepath.Join(root, "models/finance/ledger.sql")
+	state.parseDocument(uri, "{{ config(group='finance') }}\nselect * from {{ ref('revenue') }} join {{ ref('budget') }}")
+	if messages := governanceMessages(uri); len(messages) != 0 {
+		t.Errorf("expected refs within the group to be allowed, got %v", messages)
+	}
+
+	uri = "file://" + filepath.Join(root, "models/report.sql")
+	if err := os.WriteFile(filepath.Join(root, "models/report.sql"), []byte("select 1"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	state.refreshDbtContext(root)
+	state.parseDocument(uri, `select *
+from {{ ref('revenue') }}
+join {{ ref('forecast') }}
+join {{ ref('shared', 'secret') }}
+join {{ ref('open') }}
+join {{ ref('old_model') }}
+join {{ ref('dim', v=1) }}
+join {{ ref('dim') }}
+`)
+
+	expected := []string{
+		"Model 'revenue' is private to group 'finance' (owner: Finance Team)",
+		"Model 'forecast' is private to group 'finance' (owner: Finance Team)",
+		"Model 'secret' is protected in package 'shared' and can't be referenced from 'demo'",
+		"Model 'old_model' was deprecated on 2020-01-01",
+		"Version 1 of model 'dim' was deprecated on 2020-01-01",
+	}
+	if messages := governanceMessages(uri); !reflect.DeepEqual(messages, expected) {
+		t.Errorf("expected %v,\n\ngot %v", expected, messages)
+	}
+}
+
+func TestDeprecationDiagnostics(t *testing.T) {
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	token := parser.Token{Type: parser.REF, Literal: "orders"}
+	key := ReferenceKey{Type: parser.REF, Name: "orders"}
+
+	tests := []struct {
+		date     string
+		expected int
+	}{
+		{"2025-05-31", 1},
+		{"2025-06-01T00:00:00Z", 1},
+		{"2025-06-02", 0},
+		{"not a date", 0},
+		{"", 0},
+	}
+	for _, tt := range tests {
+		actual := deprecationDiagnostics(token, key, ModelDetails{DeprecationDate: tt.date}, now)
+		if len(actual) != tt.expected {
+			t.Errorf("%q: expected %d diagnostics, got %v", tt.date, tt.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package analysis
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+type Column struct {
+	Name    string
+	Type    string
+	Comment string
+}
+
+type Catalog struct {
+	Nodes   map[string]CatalogRelation `json:"nodes"`
+	Sources map[string]CatalogRelation `json:"sources"`
+}
+
+type CatalogRelation struct {
+	UniqueID string                   `json:"unique_id"`
+	Columns  map[string]CatalogColumn `json:"columns"`
+}
+
+type CatalogColumn struct {
+	Type    string `json:"type"`
+	Index   int    `json:"index"`
+	Name    string `json:"name"`
+	Comment string `json:"comment"`
+}
+
+func catalogPath(projectRoot string, projYaml DbtProjectYaml) string {
+	return filepath.Join(projectRoot, projYaml.TargetPath.Value, "catalog.json")
+}
+
+func parseCatalog(path string) (Catalog, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return Catalog{}, err
+	}
+
+	var catalog Catalog
+	if err := json.Unmarshal(contents, &catalog); err != nil {
+		return Catalog{}, err
+	}
+	return catalog, nil
+}
+
+// ColumnList returns the relation's columns in table order.
+func (r CatalogRelation) ColumnList() []Column {
+	catalogColumns := []CatalogColumn{}
+	for _, c := range r.Columns {
+		catalogColumns = append(catalogColumns, c)
+	}
+	sort.Slice(catalogColumns, func(i, j int) bool {
+		return catalogColumns[i].Index < catalogColumns[j].Index
+	})
+
+	columns := []Column{}
+	for _, c := range catalogColumns {
+		columns = append(columns, Column{Name: c.Name, Type: c.Type, Comment: c.Comment})
+	}
+	return columns
+}
+
+// applyCatalog attaches catalog columns to the models, seeds and source tables
+// they describe. Unique ids look like model.<package>.<name>[.v<version>] and
+// source.<package>.<source>.<table>, so models are matched by package, name
+// and version rather than by name alone.
+func applyCatalog(catalog Catalog, packageModelMap map[Package]map[string]ModelDetails, sourceMap map[string]Source) {
+	for uniqueID, relation := range catalog.Nodes {
+		parts := strings.SplitN(uniqueID, ".", 4)
+		if len(parts) < 3 || (parts[0] != "model" && parts[0] != "seed" && parts[0] != "snapshot") {
+			continue
+		}
+		models := packageModelMap[Package(parts[1])]
+		model, ok := models[parts[2]]
+		if !ok {
+			continue
+		}
+
+		columns := relation.ColumnList()
+		if len(parts) == 3 {
+			if model.Version == "" {
+				model.Columns = columns
+				models[parts[2]] = model
+			}
+			continue
+		}
+
+		version := strings.TrimPrefix(parts[3], "v")
+		if v, ok := model.Versions[version]; ok {
+			v.Columns = columns
+			model.Versions[version] = v
+		}
+		if model.Version == version {
+			model.Columns = columns
+			models[parts[2]] = model
+		}
+	}
+
+	for uniqueID, relation := range catalog.Sources {
+		parts := strings.Split(uniqueID, ".")
+		if len(parts) != 4 || parts[0] != "source" {
+			continue
+		}
+		table, ok := sourceMap[parts[2]].Tables[parts[3]]
+		if !ok {
+			continue
+		}
+		table.Columns = relation.ColumnList()
+		sourceMap[parts[2]].Tables[parts[3]] = table
+	}
+}
@@ -0,0 +1,117 @@
+package analysis
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/j-clemons/dbt-language-server/testutils"
+)
+
+const testCatalog = `{
+  "nodes": {
+    "model.demo.orders": {
+      "unique_id": "model.demo.orders",
+      "columns": {
+        "STATUS": {"type": "TEXT", "index": 2, "name": "STATUS", "comment": "Order status"},
+        "ORDER_ID": {"type": "NUMBER", "index": 1, "name": "ORDER_ID", "comment": null}
+      }
+    },
+    "model.demo.unknown": {
+      "unique_id": "model.demo.unknown",
+      "columns": {"ID": {"type": "NUMBER", "index": 1, "name": "ID"}}
+    }
+  },
+  "sources": {
+    "source.demo.raw.payments": {
+      "unique_id": "source.demo.raw.payments",
+      "columns": {"AMOUNT": {"type": "NUMBER", "index": 1, "name": "AMOUNT", "comment": ""}}
+    }
+  }
+}`
+
+func TestRefreshDbtContextCatalog(t *testing.T) {
+	root := testutils.CopyTestdata(t, "manifest_project")
+	setManifestTime(t, root, -time.Hour)
+	if err := os.WriteFile(filepath.Join(root, "target/catalog.json"), []byte(testCatalog), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	state := NewState()
+	state.refreshDbtContext(root)
+
+	expectedOrders := []Column{
+		{Name: "ORDER_ID", Type: "NUMBER"},
+		{Name: "STATUS", Type: "TEXT", Comment: "Order status"},
+	}
+	if actual := state.DbtContext.ModelDetailMap["orders"].Columns; !reflect.DeepEqual(actual, expectedOrders) {
+		t.Fatalf("expected %v, got %v", expectedOrders, actual)
+	}
+
+	expectedPayments := []Column{{Name: "AMOUNT", Type: "NUMBER"}}
+	if actual := state.DbtContext.SourceDetailMap["raw"].Tables["payments"].Columns; !reflect.DeepEqual(actual, expectedPayments) {
+		t.Fatalf("expected %v, got %v", expectedPayments, actual)
+	}
+
+	if _, ok := state.DbtContext.ModelDetailMap["unknown"]; ok {
+		t.Fatal("catalog entries without a model should not create one")
+	}
+}
+
+const testVersionedCatalog = `{
+  "nodes": {
+    "model.demo.orders": {
+      "unique_id": "model.demo.orders",
+      "columns": {"ORDER_ID": {"type": "NUMBER", "index": 1, "name": "ORDER_ID"}}
+    },
+    "model.shared.orders": {
+      "unique_id": "model.shared.orders",
+      "columns": {"SHARED_ID": {"type": "NUMBER", "index": 1, "name": "SHARED_ID"}}
+    },
+    "model.demo.dim_customers.v1": {
+      "unique_id": "model.demo.dim_customers.v1",
+      "columns": {"CUSTOMER_ID": {"type": "NUMBER", "index": 1, "name": "CUSTOMER_ID"}}
+    },
+    "model.demo.dim_customers.v2": {
+      "unique_id": "model.demo.dim_customers.v2",
+      "columns": {"CUSTOMER_KEY": {"type": "TEXT", "index": 1, "name": "CUSTOMER_KEY"}}
+    }
+  },
+  "sources": {}
+}`
+
+func TestRefreshDbtContextCatalogPackagesAndVersions(t *testing.T) {
+	root := testutils.CopyTestdata(t, "versioned_project")
+	if err := os.MkdirAll(filepath.Join(root, "target"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(root, "target/catalog.json"), []byte(testVersionedCatalog), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	state := NewState()
+	state.refreshDbtContext(root)
+
+	tests := []struct {
+		name     string
+		model    ModelDetails
+		expected []Column
+	}{
+		{"root orders", state.DbtContext.ModelDetailMap["orders"], []Column{{Name: "ORDER_ID", Type: "NUMBER"}}},
+		{"root orders by package", state.DbtContext.PackageModelMap["demo"]["orders"], []Column{{Name: "ORDER_ID", Type: "NUMBER"}}},
+		{"package orders", state.DbtContext.PackageModelMap["shared"]["orders"], []Column{{Name: "SHARED_ID", Type: "NUMBER"}}},
+		{"latest version", state.DbtContext.ModelDetailMap["dim_customers"], []Column{{Name: "CUSTOMER_KEY", Type: "TEXT"}}},
+		{"version 1", state.DbtContext.ModelDetailMap["dim_customers"].Versions["1"], []Column{{Name: "CUSTOMER_ID", Type: "NUMBER"}}},
+		{"version 2", state.DbtContext.ModelDetailMap["dim_customers"].Versions["2"], []Column{{Name: "CUSTOMER_KEY", Type: "TEXT"}}},
+		{"version 3", state.DbtContext.ModelDetailMap["dim_customers"].Versions["3"], nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !reflect.DeepEqual(tt.model.Columns, tt.expected) {
+				t.Fatalf("expected %v, got %v", tt.expected, tt.model.Columns)
+			}
+		})
+	}
+}
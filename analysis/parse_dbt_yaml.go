@@ -78,9 +78,15 @@ type DbtProjectYaml struct {
 	ModelPaths          AnnotatedField[[]string] `yaml:"model-paths"`
 	SeedPaths           AnnotatedField[[]string] `yaml:"seed-paths"`
 	MacroPaths          AnnotatedField[[]string] `yaml:"macro-paths"`
+	SnapshotPaths       AnnotatedField[[]string] `yaml:"snapshot-paths"`
+	TestPaths           AnnotatedField[[]string] `yaml:"test-paths"`
 	PackagesInstallPath AnnotatedField[string]   `yaml:"packages-install-path"`
 	DocsPaths           AnnotatedField[[]string] `yaml:"docs-paths"`
+	TargetPath          AnnotatedField[string]   `yaml:"target-path"`
+	RestrictAccess      AnnotatedField[bool]     `yaml:"restrict-access"`
 	Vars                AnnotatedMap             `yaml:"vars"`
+	// Models holds the `models:` configs, nested by package and folder.
+	Models map[string]any `yaml:"models"`
 }
 
 func parseDbtProjectYaml(projectRoot string) DbtProjectYaml {
@@ -124,11 +130,24 @@ func parseDbtProjectYaml(projectRoot string) DbtProjectYaml {
 			projYaml.MacroPaths.Value = []string{"macros"}
 		}
 	}
+	if projYaml.SnapshotPaths.Value == nil || len(projYaml.SnapshotPaths.Value) == 0 {
+		if availableDirs["snapshots"] == 1 {
+			projYaml.SnapshotPaths.Value = []string{"snapshots"}
+		}
+	}
+	if projYaml.TestPaths.Value == nil || len(projYaml.TestPaths.Value) == 0 {
+		if availableDirs["tests"] == 1 {
+			projYaml.TestPaths.Value = []string{"tests"}
+		}
+	}
 	if projYaml.PackagesInstallPath.Value == "" {
 		if availableDirs["dbt_packages"] == 1 {
 			projYaml.PackagesInstallPath.Value = "dbt_packages"
 		}
 	}
+	if projYaml.TargetPath.Value == "" {
+		projYaml.TargetPath.Value = "target"
+	}
 	if projYaml.DocsPaths.Value == nil || len(projYaml.DocsPaths.Value) == 0 {
 		if availableDirs["docs"] == 1 {
 			projYaml.DocsPaths.Value = []string{"docs"}
@@ -140,15 +159,114 @@ func parseDbtProjectYaml(projectRoot string) DbtProjectYaml {
 }
 
 type PropertiesYaml struct {
-	Models  []ModelProperties  `yaml:"models"`
-	Sources []SourceProperties `yaml:"sources"`
+	Models         []ModelProperties         `yaml:"models"`
+	Sources        []SourceProperties        `yaml:"sources"`
+	Macros         []MacroProperties         `yaml:"macros"`
+	Snapshots      []SnapshotProperties      `yaml:"snapshots"`
+	Exposures      []ExposureProperties      `yaml:"exposures"`
+	Metrics        []MetricProperties        `yaml:"metrics"`
+	SemanticModels []SemanticModelProperties `yaml:"semantic_models"`
+	SavedQueries   []SavedQueryProperties    `yaml:"saved_queries"`
+	Groups         []GroupProperties         `yaml:"groups"`
 }
 
 type ModelProperties struct {
+	Name          AnnotatedField[string]   `yaml:"name"`
+	Description   AnnotatedField[string]   `yaml:"description"`
+	ModelConfig   AnnotatedMap             `yaml:"config"`
+	Columns       []ColumnProperties       `yaml:"columns"`
+	LatestVersion AnnotatedField[any]      `yaml:"latest_version"`
+	Versions      []ModelVersionProperties `yaml:"versions"`
+	// Access and Group may also be set under config.
+	Access          AnnotatedField[string] `yaml:"access"`
+	Group           AnnotatedField[string] `yaml:"group"`
+	DeprecationDate AnnotatedField[string] `yaml:"deprecation_date"`
+	SchemaURI       string
+}
+
+// configString returns a model setting given either directly on the model
+// or under its config.
+func configString(field AnnotatedField[string], config AnnotatedMap, key string) AnnotatedField[string] {
+	if field.Value != "" {
+		return field
+	}
+	if value, ok := config[key].Value.(string); ok {
+		return AnnotatedField[string]{Value: value, Position: config[key].Position}
+	}
+	return field
+}
+
+// ModelVersionProperties is an entry of a versioned model's `versions:`.
+// Each version is defined in <model>_v<v>.sql unless defined_in says
+// otherwise.
+type ModelVersionProperties struct {
+	V               AnnotatedField[any]    `yaml:"v"`
+	DefinedIn       AnnotatedField[string] `yaml:"defined_in"`
+	Description     AnnotatedField[string] `yaml:"description"`
+	DeprecationDate AnnotatedField[string] `yaml:"deprecation_date"`
+}
+
+type ColumnProperties struct {
+	Name        AnnotatedField[string] `yaml:"name"`
+	Description AnnotatedField[string] `yaml:"description"`
+}
+
+// SnapshotProperties documents a snapshot block, or defines a snapshot of
+// relation when it is set.
+type SnapshotProperties struct {
+	Name        AnnotatedField[string] `yaml:"name"`
+	Description AnnotatedField[string] `yaml:"description"`
+	Relation    AnnotatedField[string] `yaml:"relation"`
+	Columns     []ColumnProperties     `yaml:"columns"`
+}
+
+type ExposureProperties struct {
 	Name        AnnotatedField[string] `yaml:"name"`
+	Label       AnnotatedField[string] `yaml:"label"`
+	Type        AnnotatedField[string] `yaml:"type"`
 	Description AnnotatedField[string] `yaml:"description"`
-	ModelConfig AnnotatedMap           `yaml:"config"`
-	SchemaURI   string
+}
+
+type MetricProperties struct {
+	Name        AnnotatedField[string] `yaml:"name"`
+	Label       AnnotatedField[string] `yaml:"label"`
+	Type        AnnotatedField[string] `yaml:"type"`
+	Description AnnotatedField[string] `yaml:"description"`
+}
+
+type SemanticModelProperties struct {
+	Name        AnnotatedField[string]      `yaml:"name"`
+	Description AnnotatedField[string]      `yaml:"description"`
+	Model       AnnotatedField[string]      `yaml:"model"`
+	Entities    []SemanticElementProperties `yaml:"entities"`
+	Dimensions  []SemanticElementProperties `yaml:"dimensions"`
+	Measures    []SemanticElementProperties `yaml:"measures"`
+}
+
+// SemanticElementProperties is an entity, dimension or measure of a semantic
+// model. Type is the entity or dimension type, or a measure's agg.
+type SemanticElementProperties struct {
+	Name        AnnotatedField[string] `yaml:"name"`
+	Type        AnnotatedField[string] `yaml:"type"`
+	Agg         AnnotatedField[string] `yaml:"agg"`
+	Description AnnotatedField[string] `yaml:"description"`
+}
+
+type SavedQueryProperties struct {
+	Name        AnnotatedField[string] `yaml:"name"`
+	Label       AnnotatedField[string] `yaml:"label"`
+	Description AnnotatedField[string] `yaml:"description"`
+}
+
+type GroupProperties struct {
+	Name        AnnotatedField[string] `yaml:"name"`
+	Description AnnotatedField[string] `yaml:"description"`
+	Owner       GroupOwnerProperties   `yaml:"owner"`
+}
+
+type GroupOwnerProperties struct {
+	Name  AnnotatedField[string] `yaml:"name"`
+	Email AnnotatedField[string] `yaml:"email"`
 }
 
 type SourceProperties struct {
@@ -164,6 +282,18 @@ type SourceTableProperties struct {
 	Description AnnotatedField[string] `yaml:"description"`
 }
 
+type MacroProperties struct {
+	Name        AnnotatedField[string]    `yaml:"name"`
+	Description AnnotatedField[string]    `yaml:"description"`
+	Arguments   []MacroArgumentProperties `yaml:"arguments"`
+}
+
+type MacroArgumentProperties struct {
+	Name        AnnotatedField[string] `yaml:"name"`
+	Type        AnnotatedField[string] `yaml:"type"`
+	Description AnnotatedField[string] `yaml:"description"`
+}
+
 func parsePropertiesYamlFile(path string) PropertiesYaml {
 	file, err := os.Open(path)
 	if err != nil {
@@ -184,6 +314,7 @@ func parsePropertiesYamlFile(path string) PropertiesYaml {
 
 type Source struct {
 	Name        string
+	ProjectName string
 	Description string
 	URI         string
 	Range       lsp.Range
@@ -196,13 +327,14 @@ type SourceTable struct {
 	Table       string
 	URI         string
 	Range       lsp.Range
+	Columns     []Column
 }
 
 func parseYamlModels(projectRoot string, projYaml DbtProjectYaml) (map[string]ModelProperties, map[string]Source) {
 	modelMap := make(map[string]ModelProperties)
 	sourceMap := make(map[string]Source)
 
-	docsFiles := getDocsFiles(projYaml)
+	docsFiles := getDocsFiles(projectRoot, projYaml)
 	docsMap := processDocsFiles(docsFiles)
 
 	for _, path := range projYaml.ModelPaths.Value {
@@ -226,12 +358,19 @@ func parseYamlModels(projectRoot string, projYaml DbtProjectYaml) (map[string]Mo
 							},
 						},
 					},
-					SchemaURI: file,
+					Columns:         model.Columns,
+					LatestVersion:   model.LatestVersion,
+					Versions:        model.Versions,
+					Access:          configString(model.Access, model.ModelConfig, "access"),
+					Group:           configString(model.Group, model.ModelConfig, "group"),
+					DeprecationDate: model.DeprecationDate,
+					SchemaURI:       file,
 				}
 			}
 			for _, source := range dbtYml.Sources {
 				sourceMap[source.Name.Value] = Source{
 					Name:        source.Name.Value,
+					ProjectName: projYaml.ProjectName.Value,
 					Description: replaceDescriptionDocsBlocks(source.Description.Value, docsMap),
 					URI:         file,
 					Range: lsp.Range{
@@ -30,6 +30,15 @@ func TestParsePropertiesYamlFile(t *testing.T) {
 					Position: lsp.Position{Line: 4, Character: 17},
 				},
 				ModelConfig: AnnotatedMap(nil),
+				Columns: []ColumnProperties{
+					documentedColumn("customer_id", 7, "This is a unique identifier for a customer", 8),
+					documentedColumn("first_name", 13, "Customer's first name. PII.", 14),
+					documentedColumn("last_name", 16, "Customer's last name. PII.", 17),
+					documentedColumn("first_order", 19, "Date (UTC) of a customer's first order", 20),
+					documentedColumn("most_recent_order", 22, "Date (UTC) of a customer's most recent order", 23),
+					documentedColumn("number_of_orders", 25, "Count of the number of orders a customer has placed", 26),
+					documentedColumn("total_order_amount", 28, "Total value (AUD) of a customer's orders", 29),
+				},
 			},
 			{
 				Name: AnnotatedField[string]{
@@ -41,6 +50,17 @@ func TestParsePropertiesYamlFile(t *testing.T) {
 					Position: lsp.Position{Line: 32, Character: 17},
 				},
 				ModelConfig: AnnotatedMap(nil),
+				Columns: []ColumnProperties{
+					documentedColumn("order_id", 35, "This is a unique identifier for an order", 39),
+					documentedColumn("customer_id", 41, "Foreign key to the customers table", 42),
+					documentedColumn("order_date", 49, "Date (UTC) that the order was placed", 50),
+					documentedColumn("status", 52, "{{ doc(\"orders_status\") }}", 53),
+					documentedColumn("amount", 58, "Total amount (AUD) of the order", 59),
+					documentedColumn("credit_card_amount", 63, "Amount of the order (AUD) paid for by credit card", 64),
+					documentedColumn("coupon_amount", 68, "Amount of the order (AUD) paid for by coupon", 69),
+					documentedColumn("bank_transfer_amount", 73, "Amount of the order (AUD) paid for by bank transfer", 74),
+					documentedColumn("gift_card_amount", 78, "Amount of the order (AUD) paid for by gift card", 79),
+				},
 			},
 		},
 		Sources: []SourceProperties{
@@ -122,3 +142,10 @@ func TestParsePropertiesYamlFile(t *testing.T) {
 		t.Errorf("expected %#v but got %#v", expectedProperties, actualProperties)
 	}
 }
+
+func documentedColumn(name string, nameLine int, description string, descriptionLine int) ColumnProperties {
+	return ColumnProperties{
+		Name:        AnnotatedField[string]{Value: name, Position: lsp.Position{Line: nameLine, Character: 14}},
+		Description: AnnotatedField[string]{Value: description, Position: lsp.Position{Line: descriptionLine, Character: 21}},
+	}
+}
@@ -2,6 +2,7 @@ package analysis
 
 import (
 	"os"
+	"path/filepath"
 	"regexp"
 	"strings"
 
@@ -10,15 +11,84 @@ import (
 )
 
 type Macro struct {
+	Name          string
+	ProjectName   Package
+	Description   string
+	Documentation string
+	Arguments     []MacroArgument
+	URI           string
+	Range         lsp.Range
+}
+
+type MacroArgument struct {
 	Name        string
-	ProjectName Package
+	Default     string
+	Type        string
 	Description string
-	URI         string
-	Range       lsp.Range
+}
+
+// splitTopLevel splits s on sep, ignoring separators inside quotes and
+// brackets so defaults like `cols=['a', 'b']` stay intact.
+func splitTopLevel(s string, sep byte) []string {
+	parts := []string{}
+	depth := 0
+	var quote byte
+	start := 0
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case quote != 0:
+			if c == '\\' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"':
+			quote = c
+		case c == '(' || c == '[' || c == '{':
+			depth++
+		case c == ')' || c == ']' || c == '}':
+			depth--
+		case c == sep && depth == 0:
+			parts = append(parts, s[start:i])
+			start = i + 1
+		}
+	}
+	return append(parts, s[start:])
+}
+
+// parseMacroArguments reads the arguments out of a signature such as
+// `my_macro(column, quote=true)`.
+func parseMacroArguments(signature string) []MacroArgument {
+	open := strings.Index(signature, "(")
+	end := strings.LastIndex(signature, ")")
+	if open == -1 || end <= open {
+		return nil
+	}
+
+	var args []MacroArgument
+	for _, part := range splitTopLevel(signature[open+1:end], ',') {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		name, def, _ := strings.Cut(part, "=")
+		args = append(args, MacroArgument{
+			Name:    strings.TrimSpace(name),
+			Default: strings.TrimSpace(def),
+		})
+	}
+	return args
 }
 
 func getMacrosFromFile(fileStr string, fileUri string, dbtProjectYaml DbtProjectYaml) []Macro {
-	macroDescRegex := regexp.MustCompile(`(?s)\{%-{0,1}\s*macro\s+(\w+\(.*?\))\s*-{0,1}%\}`)
+	return getJinjaBlocksFromFile("macro", fileStr, fileUri, dbtProjectYaml)
+}
+
+// getJinjaBlocksFromFile reads the signature of each `{% tag name(args) %}`
+// block, e.g. macros and generic tests.
+func getJinjaBlocksFromFile(tag string, fileStr string, fileUri string, dbtProjectYaml DbtProjectYaml) []Macro {
+	macroDescRegex := regexp.MustCompile(`(?s)\{%-{0,1}\s*` + tag + `\s+(\w+\(.*?\))\s*-{0,1}%\}`)
 	macroMatches := macroDescRegex.FindAllStringSubmatchIndex(fileStr, -1)
 
 	macros := []Macro{}
@@ -35,6 +105,7 @@ func getMacrosFromFile(fileStr string, fileUri string, dbtProjectYaml DbtProject
 				Name:        fileStr[m[2]:m[3]][:macroNameIdx],
 				ProjectName: Package(dbtProjectYaml.ProjectName.Value),
 				Description: fileStr[m[2]:m[3]],
+				Arguments:   parseMacroArguments(fileStr[m[2]:m[3]]),
 				URI:         fileUri,
 				Range: lsp.Range{
 					Start: lsp.Position{
@@ -78,5 +149,48 @@ func parseMacros(projectRoot string, dbtProjectYaml DbtProjectYaml) ([]Macro, er
 			)
 		}
 	}
+
+	properties := parseYamlMacros(projectRoot, dbtProjectYaml)
+	for i := range macros {
+		if props, ok := properties[macros[i].Name]; ok {
+			applyMacroProperties(&macros[i], props)
+		}
+	}
+
 	return macros, err
 }
+
+// parseYamlMacros reads `macros:` entries from properties files in the model
+// and macro paths.
+func parseYamlMacros(projectRoot string, dbtProjectYaml DbtProjectYaml) map[string]MacroProperties {
+	macroMap := make(map[string]MacroProperties)
+
+	paths := []string{}
+	paths = append(paths, dbtProjectYaml.ModelPaths.Value...)
+	paths = append(paths, dbtProjectYaml.MacroPaths.Value...)
+
+	for _, p := range paths {
+		files, err := util.WalkFilepath(filepath.Join(projectRoot, p), ".yml")
+		if err != nil {
+			continue
+		}
+		for _, file := range files {
+			for _, macro := range parsePropertiesYamlFile(file).Macros {
+				macroMap[macro.Name.Value] = macro
+			}
+		}
+	}
+	return macroMap
+}
+
+func applyMacroProperties(macro *Macro, props MacroProperties) {
+	macro.Documentation = props.Description.Value
+	for _, argProps := range props.Arguments {
+		for i := range macro.Arguments {
+			if macro.Arguments[i].Name == argProps.Name.Value {
+				macro.Arguments[i].Type = argProps.Type.Value
+				macro.Arguments[i].Description = argProps.Description.Value
+			}
+		}
+	}
+}
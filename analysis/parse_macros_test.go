@@ -1,6 +1,7 @@
 package analysis
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/j-clemons/dbt-language-server/lsp"
@@ -43,6 +44,7 @@ func TestGetMacrosFromFile(t *testing.T) {
 					Name:        "example_macro",
 					ProjectName: "example",
 					Description: "example_macro(str)",
+					Arguments:   []MacroArgument{{Name: "str"}},
 					URI:         "file:///path/to/file.sql",
 					Range: lsp.Range{
 						Start: lsp.Position{
@@ -59,6 +61,7 @@ func TestGetMacrosFromFile(t *testing.T) {
 					Name:        "multiline_macro",
 					ProjectName: "example",
 					Description: "multiline_macro(\n    str,\n    int\n)",
+					Arguments:   []MacroArgument{{Name: "str"}, {Name: "int"}},
 					URI:         "file:///path/to/file.sql",
 					Range: lsp.Range{
 						Start: lsp.Position{
@@ -79,7 +82,7 @@ func TestGetMacrosFromFile(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			result := getMacrosFromFile(tc.fileStr, tc.fileUri, tc.dbtProjectYaml)
 			for i, e := range tc.expected {
-				if e != result[i] {
+				if !reflect.DeepEqual(e, result[i]) {
 					t.Errorf("input: %v; got: %v; want: %v",
 						tc.fileStr, result[i], e)
 				}
@@ -87,3 +90,46 @@ func TestGetMacrosFromFile(t *testing.T) {
 		})
 	}
 }
+
+func TestParseMacroArguments(t *testing.T) {
+	testCases := []struct {
+		name      string
+		signature string
+		expected  []MacroArgument
+	}{
+		{
+			name:      "no arguments",
+			signature: "no_args()",
+			expected:  nil,
+		},
+		{
+			name:      "defaults",
+			signature: "star(from, except=[], relation_alias=False, quote_identifiers=True)",
+			expected: []MacroArgument{
+				{Name: "from"},
+				{Name: "except", Default: "[]"},
+				{Name: "relation_alias", Default: "False"},
+				{Name: "quote_identifiers", Default: "True"},
+			},
+		},
+		{
+			name:      "separators inside defaults",
+			signature: "pivot(column, values=['a', 'b'], sep=',', agg=fn(1, 2))",
+			expected: []MacroArgument{
+				{Name: "column"},
+				{Name: "values", Default: "['a', 'b']"},
+				{Name: "sep", Default: "','"},
+				{Name: "agg", Default: "fn(1, 2)"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := parseMacroArguments(tc.signature)
+			if !reflect.DeepEqual(result, tc.expected) {
+				t.Errorf("got: %v; want: %v", result, tc.expected)
+			}
+		})
+	}
+}
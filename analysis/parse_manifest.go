@@ -0,0 +1,624 @@
+package analysis
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/j-clemons/dbt-language-server/analysis/parser"
+	"github.com/j-clemons/dbt-language-server/lsp"
+	"github.com/j-clemons/dbt-language-server/util"
+)
+
+type Manifest struct {
+	Nodes          map[string]ManifestNode         `json:"nodes"`
+	Sources        map[string]ManifestSource       `json:"sources"`
+	Macros         map[string]ManifestMacro        `json:"macros"`
+	Docs           map[string]ManifestDoc          `json:"docs"`
+	Exposures      map[string]ManifestSemanticNode `json:"exposures"`
+	Metrics        map[string]ManifestSemanticNode `json:"metrics"`
+	SemanticModels map[string]ManifestSemanticNode `json:"semantic_models"`
+	SavedQueries   map[string]ManifestSemanticNode `json:"saved_queries"`
+	Groups         map[string]ManifestSemanticNode `json:"groups"`
+}
+
+type ManifestNode struct {
+	UniqueID         string         `json:"unique_id"`
+	ResourceType     string         `json:"resource_type"`
+	PackageName      string         `json:"package_name"`
+	Name             string         `json:"name"`
+	OriginalFilePath string         `json:"original_file_path"`
+	PatchPath        string         `json:"patch_path"`
+	Description      string         `json:"description"`
+	Config           map[string]any `json:"config"`
+	Version          any            `json:"version"`
+	LatestVersion    any            `json:"latest_version"`
+	Access           string         `json:"access"`
+	Group            string         `json:"group"`
+	DeprecationDate  string         `json:"deprecation_date"`
+	RawCode          string         `json:"raw_code"`
+}
+
+type ManifestSource struct {
+	UniqueID          string `json:"unique_id"`
+	PackageName       string `json:"package_name"`
+	SourceName        string `json:"source_name"`
+	Name              string `json:"name"`
+	OriginalFilePath  string `json:"original_file_path"`
+	Description       string `json:"description"`
+	SourceDescription string `json:"source_description"`
+}
+
+type ManifestMacro struct {
+	UniqueID         string                  `json:"unique_id"`
+	PackageName      string                  `json:"package_name"`
+	Name             string                  `json:"name"`
+	OriginalFilePath string                  `json:"original_file_path"`
+	Description      string                  `json:"description"`
+	Arguments        []ManifestMacroArgument `json:"arguments"`
+}
+
+type ManifestMacroArgument struct {
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	Description string `json:"description"`
+}
+
+type ManifestDoc struct {
+	UniqueID         string `json:"unique_id"`
+	PackageName      string `json:"package_name"`
+	Name             string `json:"name"`
+	OriginalFilePath string `json:"original_file_path"`
+	BlockContents    string `json:"block_contents"`
+}
+
+// ManifestSemanticNode is an exposure, metric, semantic model, saved query
+// or group.
+type ManifestSemanticNode struct {
+	UniqueID         string `json:"unique_id"`
+	PackageName      string `json:"package_name"`
+	Name             string `json:"name"`
+	OriginalFilePath string `json:"original_file_path"`
+	Description      string `json:"description"`
+}
+
+func manifestPath(projectRoot string, projYaml DbtProjectYaml) string {
+	return filepath.Join(projectRoot, projYaml.TargetPath.Value, "manifest.json")
+}
+
+// manifestIsFresh reports whether the manifest was written after every
+// project file dbt reads to build it. Installed packages only change when
+// packages.yml or the lock file does, so only those are checked for them.
+// Only file modification times are checked so this is far cheaper than
+// parsing the project.
+func manifestIsFresh(path string, projectRoot string, projYaml DbtProjectYaml) bool {
+	info, err := os.Stat(path)
+	if err != nil {
+		return false
+	}
+	manifestTime := info.ModTime()
+
+	projectFile, err := os.Stat(filepath.Join(projectRoot, "dbt_project.yml"))
+	if err != nil || projectFile.ModTime().After(manifestTime) {
+		return false
+	}
+	for _, name := range []string{"packages.yml", "dependencies.yml", "package-lock.yml"} {
+		packagesFile, err := os.Stat(filepath.Join(projectRoot, name))
+		if err == nil && packagesFile.ModTime().After(manifestTime) {
+			return false
+		}
+	}
+
+	paths := []string{}
+	paths = append(paths, projYaml.ModelPaths.Value...)
+	paths = append(paths, projYaml.SeedPaths.Value...)
+	paths = append(paths, projYaml.MacroPaths.Value...)
+	paths = append(paths, projYaml.SnapshotPaths.Value...)
+	paths = append(paths, projYaml.TestPaths.Value...)
+	paths = append(paths, projYaml.DocsPaths.Value...)
+
+	stale := false
+	for _, p := range paths {
+		filepath.WalkDir(filepath.Join(projectRoot, p), func(path string, d fs.DirEntry, err error) error {
+			if err != nil || d.IsDir() {
+				return nil
+			}
+			fileInfo, err := d.Info()
+			if err == nil && fileInfo.ModTime().After(manifestTime) {
+				stale = true
+				return filepath.SkipAll
+			}
+			return nil
+		})
+		if stale {
+			return false
+		}
+	}
+
+	return true
+}
+
+func parseManifest(path string) (Manifest, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return Manifest{}, err
+	}
+
+	var manifest Manifest
+	if err := json.Unmarshal(contents, &manifest); err != nil {
+		return Manifest{}, err
+	}
+	return manifest, nil
+}
+
+// loadManifest returns the project manifest when it is present and newer
+// than the project sources.
+func (s *State) loadManifest() (Manifest, bool) {
+	path := manifestPath(s.DbtContext.ProjectRoot, s.DbtContext.ProjectYaml)
+	if !manifestIsFresh(path, s.DbtContext.ProjectRoot, s.DbtContext.ProjectYaml) {
+		return Manifest{}, false
+	}
+
+	manifest, err := parseManifest(path)
+	if err != nil {
+		return Manifest{}, false
+	}
+	return manifest, true
+}
+
+// packageRoots maps each installed package, and the root project, to its
+// directory. Manifest paths are relative to these.
+func (s *State) packageRoots() map[string]string {
+	roots := map[string]string{
+		s.DbtContext.ProjectYaml.ProjectName.Value: s.DbtContext.ProjectRoot,
+	}
+	for _, p := range getPackageModelDetails(s.DbtContext.ProjectRoot, s.DbtContext.ProjectYaml) {
+		roots[p.DbtProjectYaml.ProjectName.Value] = p.RootPath
+	}
+	return roots
+}
+
+// propertiesCache parses each properties file once so yaml positions can be
+// attached to manifest entries, which don't record line numbers.
+type propertiesCache map[string]PropertiesYaml
+
+func (c propertiesCache) get(path string) PropertiesYaml {
+	if props, ok := c[path]; ok {
+		return props
+	}
+	props := parsePropertiesYamlFile(path)
+	c[path] = props
+	return props
+}
+
+func (s *State) getManifestModelDetails(manifest Manifest) (map[Package]map[string]ModelDetails, map[string]Source) {
+	packageModelMap := make(map[Package]map[string]ModelDetails)
+	sourceMap := make(map[string]Source)
+	// versions of each versioned model, by package and model name
+	versionMap := make(map[Package]map[string]map[string]ModelDetails)
+	latestVersions := make(map[Package]map[string]string)
+
+	roots := s.packageRoots()
+	cache := propertiesCache{}
+	restricted := map[Package]bool{
+		Package(s.DbtContext.ProjectYaml.ProjectName.Value): s.DbtContext.ProjectYaml.RestrictAccess.Value,
+	}
+	for _, p := range getPackageModelDetails(s.DbtContext.ProjectRoot, s.DbtContext.ProjectYaml) {
+		restricted[Package(p.DbtProjectYaml.ProjectName.Value)] = p.DbtProjectYaml.RestrictAccess.Value
+	}
+
+	for _, node := range manifest.Nodes {
+		if node.ResourceType != "model" && node.ResourceType != "seed" && node.ResourceType != "snapshot" {
+			continue
+		}
+		root, ok := roots[node.PackageName]
+		if !ok {
+			continue
+		}
+		modelMapKey := node.Name
+		if alias, ok := node.Config["alias"].(string); ok && alias != "" {
+			modelMapKey = alias
+		}
+
+		details := ModelDetails{
+			URI:             filepath.Join(root, node.OriginalFilePath),
+			ProjectName:     node.PackageName,
+			Description:     node.Description,
+			Group:           node.Group,
+			DeprecationDate: node.DeprecationDate,
+		}
+		// every model has an access in the manifest, protected unless set
+		if node.Access != "protected" {
+			details.Access = node.Access
+		}
+		if node.ResourceType == "seed" && details.Description == "" {
+			details.Description = "Seed File"
+		}
+		if node.ResourceType == "snapshot" {
+			details.Snapshot = true
+			details.Range = snapshotRange(details.URI, node.Name)
+			if details.Description == "" {
+				details.Description = "Snapshot"
+			}
+		}
+
+		// patch_path looks like "jaffle_shop://models/schema.yml"
+		if _, patchFile, found := strings.Cut(node.PatchPath, "://"); found {
+			details.SchemaURI = filepath.Join(root, patchFile)
+			props := cache.get(details.SchemaURI)
+			names := []AnnotatedField[string]{}
+			for _, model := range props.Models {
+				names = append(names, model.Name)
+			}
+			for _, snapshot := range props.Snapshots {
+				names = append(names, snapshot.Name)
+			}
+			for _, name := range names {
+				if name.Value == node.Name {
+					details.SchemaRange = lsp.Range{
+						Start: name.Position,
+						End:   name.Position,
+					}
+					break
+				}
+			}
+		}
+
+		pkg := Package(node.PackageName)
+		if node.Version != nil {
+			// versioned models share a name and are folded together below
+			details.Version = fmt.Sprint(node.Version)
+			if versionMap[pkg] == nil {
+				versionMap[pkg] = make(map[string]map[string]ModelDetails)
+				latestVersions[pkg] = make(map[string]string)
+			}
+			if versionMap[pkg][node.Name] == nil {
+				versionMap[pkg][node.Name] = make(map[string]ModelDetails)
+			}
+			versionMap[pkg][node.Name][details.Version] = details
+			if node.LatestVersion != nil {
+				latestVersions[pkg][node.Name] = fmt.Sprint(node.LatestVersion)
+			}
+			continue
+		}
+
+		if packageModelMap[pkg] == nil {
+			packageModelMap[pkg] = make(map[string]ModelDetails)
+		}
+		packageModelMap[pkg][modelMapKey] = details
+	}
+
+	for pkg, models := range versionMap {
+		for name, versions := range models {
+			latest := latestVersions[pkg][name]
+			if _, ok := versions[latest]; !ok {
+				latest = ""
+				for version := range versions {
+					if latest == "" || compareVersions(version, latest) > 0 {
+						latest = version
+					}
+				}
+			}
+			model := versions[latest]
+			model.Versions = versions
+			if packageModelMap[pkg] == nil {
+				packageModelMap[pkg] = make(map[string]ModelDetails)
+			}
+			packageModelMap[pkg][name] = model
+		}
+	}
+	for pkg, models := range packageModelMap {
+		if restricted[pkg] {
+			restrictAccess(models)
+		}
+	}
+
+	for _, manifestSource := range manifest.Sources {
+		root, ok := roots[manifestSource.PackageName]
+		if !ok {
+			continue
+		}
+		file := filepath.Join(root, manifestSource.OriginalFilePath)
+
+		source, ok := sourceMap[manifestSource.SourceName]
+		if !ok {
+			source = Source{
+				Name:        manifestSource.SourceName,
+				ProjectName: manifestSource.PackageName,
+				Description: manifestSource.SourceDescription,
+				URI:         file,
+				Tables:      make(map[string]SourceTable),
+			}
+		}
+
+		table := SourceTable{
+			Name:        manifestSource.Name,
+			Description: manifestSource.Description,
+			Table:       manifestSource.SourceName,
+			URI:         file,
+		}
+		for _, sourceProps := range cache.get(file).Sources {
+			if sourceProps.Name.Value != manifestSource.SourceName {
+				continue
+			}
+			source.Range = lsp.Range{Start: sourceProps.Name.Position, End: sourceProps.Name.Position}
+			for _, tableProps := range sourceProps.Tables {
+				if tableProps.Name.Value == manifestSource.Name {
+					table.Range = lsp.Range{Start: tableProps.Name.Position, End: tableProps.Name.Position}
+				}
+			}
+		}
+
+		source.Tables[table.Name] = table
+		sourceMap[source.Name] = source
+	}
+
+	return packageModelMap, sourceMap
+}
+
+func (s *State) getManifestMacroDetails(manifest Manifest) map[Package]map[string]Macro {
+	packageMacroMap := make(map[Package]map[string]Macro)
+
+	roots := s.packageRoots()
+
+	// the manifest has no line numbers, so each macro file is read once to
+	// find signatures and ranges; docs and arguments come from the manifest
+	fileMacros := make(map[string]map[string]Macro)
+	for _, manifestMacro := range manifest.Macros {
+		// dbt and adapter internals aren't installed packages
+		root, ok := roots[manifestMacro.PackageName]
+		if !ok {
+			continue
+		}
+
+		file := filepath.Join(root, manifestMacro.OriginalFilePath)
+		macros, ok := fileMacros[file]
+		if !ok {
+			macros = make(map[string]Macro)
+			fileContents, err := util.ReadFileContents(file)
+			if err == nil {
+				projYaml := DbtProjectYaml{ProjectName: AnnotatedField[string]{Value: manifestMacro.PackageName}}
+				for _, m := range getMacrosFromFile(fileContents, file, projYaml) {
+					macros[m.Name] = m
+				}
+			}
+			fileMacros[file] = macros
+		}
+
+		macro, ok := macros[manifestMacro.Name]
+		if !ok {
+			continue
+		}
+
+		props := MacroProperties{
+			Name:        AnnotatedField[string]{Value: manifestMacro.Name},
+			Description: AnnotatedField[string]{Value: manifestMacro.Description},
+		}
+		for _, arg := range manifestMacro.Arguments {
+			props.Arguments = append(props.Arguments, MacroArgumentProperties{
+				Name:        AnnotatedField[string]{Value: arg.Name},
+				Type:        AnnotatedField[string]{Value: arg.Type},
+				Description: AnnotatedField[string]{Value: arg.Description},
+			})
+		}
+		applyMacroProperties(&macro, props)
+
+		if packageMacroMap[macro.ProjectName] == nil {
+			packageMacroMap[macro.ProjectName] = make(map[string]Macro)
+		}
+		packageMacroMap[macro.ProjectName][macro.Name] = macro
+	}
+
+	return packageMacroMap
+}
+
+// getManifestDocsDetails indexes the manifest's doc blocks. Their files are
+// only read to find where each block is defined.
+func (s *State) getManifestDocsDetails(manifest Manifest) map[Package]map[string]Docs {
+	packageDocsMap := make(map[Package]map[string]Docs)
+
+	roots := s.packageRoots()
+	fileDocs := make(map[string]map[string]Docs)
+	for _, manifestDoc := range manifest.Docs {
+		root, ok := roots[manifestDoc.PackageName]
+		if !ok {
+			continue
+		}
+
+		file := filepath.Join(root, manifestDoc.OriginalFilePath)
+		docs, ok := fileDocs[file]
+		if !ok {
+			docs = make(map[string]Docs)
+			if fileContents, err := util.ReadFileContents(file); err == nil {
+				docs = makeDocsMap(getDocsFileContents(fileContents, file))
+			}
+			fileDocs[file] = docs
+		}
+
+		doc, ok := docs[manifestDoc.Name]
+		if !ok {
+			doc = Docs{Name: manifestDoc.Name, URI: file}
+		}
+		doc.Content = strings.TrimSpace(manifestDoc.BlockContents)
+		doc.ProjectName = Package(manifestDoc.PackageName)
+
+		if packageDocsMap[doc.ProjectName] == nil {
+			packageDocsMap[doc.ProjectName] = make(map[string]Docs)
+		}
+		packageDocsMap[doc.ProjectName][doc.Name] = doc
+	}
+
+	return packageDocsMap
+}
+
+// manifestModelCode maps the path of each SQL model to the code the manifest
+// holds for it, which saves reading the file.
+func (s *State) manifestModelCode(manifest Manifest) map[string]string {
+	code := make(map[string]string)
+
+	roots := s.packageRoots()
+	for _, node := range manifest.Nodes {
+		root, ok := roots[node.PackageName]
+		if !ok || node.ResourceType != "model" || filepath.Ext(node.OriginalFilePath) != ".sql" {
+			continue
+		}
+		code[filepath.Join(root, node.OriginalFilePath)] = node.RawCode
+	}
+	return code
+}
+
+// getManifestReferenceIndex indexes the references made by the models,
+// snapshots, singular tests, macros and semantic nodes in the manifest.
+// Files without Jinja can't reference anything and aren't tokenized.
+func (s *State) getManifestReferenceIndex(manifest Manifest) map[ReferenceKey][]Reference {
+	referenceIndex := make(map[ReferenceKey][]Reference)
+	add := func(references []Reference) {
+		for _, r := range references {
+			referenceIndex[r.Key.indexKey()] = append(referenceIndex[r.Key.indexKey()], r)
+		}
+	}
+
+	roots := s.packageRoots()
+	code := s.manifestModelCode(manifest)
+	seen := make(map[string]bool)
+	addSqlFile := func(file string, projectName string) {
+		if seen[file] || filepath.Ext(file) != ".sql" {
+			return
+		}
+		seen[file] = true
+
+		text, ok := code[file]
+		if !ok {
+			var err error
+			if text, err = util.ReadFileContents(file); err != nil {
+				return
+			}
+		}
+		if !strings.Contains(text, "{{") && !strings.Contains(text, "{%") {
+			return
+		}
+		tokens := parser.Parse(text, s.DbtContext.Dialect).CreateTokenIndex().Tokens()
+		add(getReferencesFromTokens(tokens, file, projectName))
+	}
+
+	for _, node := range manifest.Nodes {
+		switch node.ResourceType {
+		case "model", "snapshot", "test":
+		default:
+			continue
+		}
+		if root, ok := roots[node.PackageName]; ok {
+			addSqlFile(filepath.Join(root, node.OriginalFilePath), node.PackageName)
+		}
+	}
+	for _, macro := range manifest.Macros {
+		if root, ok := roots[macro.PackageName]; ok {
+			addSqlFile(filepath.Join(root, macro.OriginalFilePath), macro.PackageName)
+		}
+	}
+
+	for _, nodes := range []map[string]ManifestSemanticNode{manifest.Exposures, manifest.Metrics, manifest.SemanticModels, manifest.SavedQueries} {
+		for _, node := range nodes {
+			root, ok := roots[node.PackageName]
+			if !ok {
+				continue
+			}
+			file := filepath.Join(root, node.OriginalFilePath)
+			if seen[file] {
+				continue
+			}
+			seen[file] = true
+			add(semanticReferences(file, node.PackageName, s.DbtContext.Dialect))
+		}
+	}
+
+	return referenceIndex
+}
+
+// getManifestGenericTestDetails indexes the generic tests defined by the
+// manifest's test_ macros, reading only the files they are in.
+func (s *State) getManifestGenericTestDetails(manifest Manifest, macroMap map[Package]map[string]Macro) map[Package]map[string]Macro {
+	tests := []Macro{}
+
+	roots := s.packageRoots()
+	seen := make(map[string]bool)
+	for _, macro := range manifest.Macros {
+		root, ok := roots[macro.PackageName]
+		if !ok || !strings.HasPrefix(macro.Name, "test_") {
+			continue
+		}
+		file := filepath.Join(root, macro.OriginalFilePath)
+		if seen[file] {
+			continue
+		}
+		seen[file] = true
+
+		fileContents, err := util.ReadFileContents(file)
+		if err != nil {
+			continue
+		}
+		projYaml := DbtProjectYaml{ProjectName: AnnotatedField[string]{Value: macro.PackageName}}
+		tests = append(tests, getJinjaBlocksFromFile("test", fileContents, file, projYaml)...)
+	}
+
+	return genericTestMap(tests, macroMap)
+}
+
+// getManifestSingularTests maps each singular test of the project to its
+// path. Generic test instances are defined in properties files and skipped.
+func (s *State) getManifestSingularTests(manifest Manifest) map[string]string {
+	tests := make(map[string]string)
+	for _, node := range manifest.Nodes {
+		if node.ResourceType != "test" || node.PackageName != s.DbtContext.ProjectYaml.ProjectName.Value || filepath.Ext(node.OriginalFilePath) != ".sql" {
+			continue
+		}
+		file := filepath.Join(s.DbtContext.ProjectRoot, node.OriginalFilePath)
+		tests[strings.TrimSuffix(filepath.Base(file), ".sql")] = file
+	}
+	return tests
+}
+
+// getManifestSemanticDetails indexes the manifest's exposures, metrics,
+// semantic models, saved queries and groups. Descriptions come from the
+// manifest with doc blocks already rendered; the properties files are only
+// read for positions and the elements of semantic models.
+func (s *State) getManifestSemanticDetails(manifest Manifest) map[SemanticKind]map[string]SemanticNode {
+	nodeMap := newSemanticNodeMap()
+
+	roots := s.packageRoots()
+	fileNodes := make(map[string]map[SemanticKind]map[string]SemanticNode)
+	kinds := map[SemanticKind]map[string]ManifestSemanticNode{
+		ExposureKind:      manifest.Exposures,
+		MetricKind:        manifest.Metrics,
+		SemanticModelKind: manifest.SemanticModels,
+		SavedQueryKind:    manifest.SavedQueries,
+		GroupKind:         manifest.Groups,
+	}
+	for kind, entries := range kinds {
+		for _, entry := range entries {
+			root, ok := roots[entry.PackageName]
+			if !ok {
+				continue
+			}
+
+			file := filepath.Join(root, entry.OriginalFilePath)
+			nodes, ok := fileNodes[file]
+			if !ok {
+				nodes = newSemanticNodeMap()
+				addFileSemanticNodes(nodes, file, entry.PackageName, nil)
+				fileNodes[file] = nodes
+			}
+
+			node, ok := nodes[kind][entry.Name]
+			if !ok {
+				node = SemanticNode{Name: entry.Name, Kind: kind, ProjectName: entry.PackageName, URI: file}
+			}
+			node.Description = entry.Description
+			nodeMap[kind][entry.Name] = node
+		}
+	}
+	return nodeMap
+}
@@ -0,0 +1,252 @@
+package analysis
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/j-clemons/dbt-language-server/analysis/parser"
+	"github.com/j-clemons/dbt-language-server/lsp"
+	"github.com/j-clemons/dbt-language-server/testutils"
+)
+
+func setManifestTime(t *testing.T, root string, offset time.Duration) {
+	t.Helper()
+
+	when := time.Now().Add(offset)
+	if err := os.Chtimes(filepath.Join(root, "target/manifest.json"), when, when); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRefreshDbtContextFromManifest(t *testing.T) {
+	root := testutils.CopyTestdata(t, "manifest_project")
+	setManifestTime(t, root, time.Hour)
+
+	state := NewState()
+	state.refreshDbtContext(root)
+
+	expectedModels := map[string]ModelDetails{
+		"orders": {
+			URI:         filepath.Join(root, "models/orders.sql"),
+			ProjectName: "demo",
+			Description: "Resolved by dbt",
+			SchemaURI:   filepath.Join(root, "models/schema.yml"),
+			SchemaRange: lsp.Range{
+				Start: lsp.Position{Line: 3, Character: 10},
+				End:   lsp.Position{Line: 3, Character: 10},
+			},
+			InferredColumns: []Column{{Name: "order_id"}},
+		},
+		"customers": {
+			URI:         filepath.Join(root, "models/customers_v2.sql"),
+			ProjectName: "demo",
+			Version:     "2",
+			Versions: map[string]ModelDetails{
+				"1": {
+					URI:         filepath.Join(root, "models/customers_v1.sql"),
+					ProjectName: "demo",
+					Version:     "1",
+				},
+				"2": {
+					URI:         filepath.Join(root, "models/customers_v2.sql"),
+					ProjectName: "demo",
+					Version:     "2",
+				},
+			},
+		},
+	}
+	if !reflect.DeepEqual(state.DbtContext.ModelDetailMap, expectedModels) {
+		t.Fatalf("expected models %v,\n\ngot %v", expectedModels, state.DbtContext.ModelDetailMap)
+	}
+
+	expectedSources := map[string]Source{
+		"raw": {
+			Name:        "raw",
+			ProjectName: "demo",
+			Description: "Raw data",
+			URI:         filepath.Join(root, "models/schema.yml"),
+			Range: lsp.Range{
+				Start: lsp.Position{Line: 7, Character: 10},
+				End:   lsp.Position{Line: 7, Character: 10},
+			},
+			Tables: map[string]SourceTable{
+				"payments": {
+					Name:        "payments",
+					Description: "Payments table",
+					Table:       "raw",
+					URI:         filepath.Join(root, "models/schema.yml"),
+					Range: lsp.Range{
+						Start: lsp.Position{Line: 9, Character: 14},
+						End:   lsp.Position{Line: 9, Character: 14},
+					},
+				},
+			},
+		},
+	}
+	if !reflect.DeepEqual(state.DbtContext.SourceDetailMap, expectedSources) {
+		t.Fatalf("expected sources %v,\n\ngot %v", expectedSources, state.DbtContext.SourceDetailMap)
+	}
+
+	expectedMacros := map[Package]map[string]Macro{
+		"demo": {
+			"cents": {
+				Name:          "cents",
+				ProjectName:   "demo",
+				Description:   "cents(col, scale=2)",
+				Documentation: "Converts to cents.",
+				Arguments: []MacroArgument{
+					{Name: "col", Type: "column", Description: "Amount column."},
+					{Name: "scale", Default: "2"},
+				},
+				URI: filepath.Join(root, "macros/cents.sql"),
+				Range: lsp.Range{
+					Start: lsp.Position{Line: 0, Character: 9},
+					End:   lsp.Position{Line: 0, Character: 28},
+				},
+			},
+		},
+	}
+	if !reflect.DeepEqual(state.DbtContext.MacroDetailMap, expectedMacros) {
+		t.Fatalf("expected macros %v,\n\ngot %v", expectedMacros, state.DbtContext.MacroDetailMap)
+	}
+}
+
+func TestIndexesFromManifest(t *testing.T) {
+	root := testutils.CopyTestdata(t, "manifest_project")
+	setManifestTime(t, root, time.Hour)
+
+	state := NewState()
+	state.refreshDbtContext(root)
+
+	refURIs := func(name string) []string {
+		uris := []string{}
+		for _, r := range state.DbtContext.ReferenceIndex[ReferenceKey{Type: parser.REF, Name: name}] {
+			uris = append(uris, r.URI)
+		}
+		sort.Strings(uris)
+		return uris
+	}
+	// models/unlisted.sql isn't in the manifest
+	expectedOrdersRefs := []string{
+		filepath.Join(root, "models/schema.yml"),
+		filepath.Join(root, "tests/assert_positive_orders.sql"),
+	}
+	if actual := refURIs("orders"); !reflect.DeepEqual(actual, expectedOrdersRefs) {
+		t.Errorf("expected refs to orders in %v, got %v", expectedOrdersRefs, actual)
+	}
+	if actual := refURIs("customers"); !reflect.DeepEqual(actual, []string{filepath.Join(root, "models/orders.sql")}) {
+		t.Errorf("expected a ref to customers in orders.sql, got %v", actual)
+	}
+
+	expectedDocs := map[Package]map[string]Docs{
+		"demo": {
+			"order_status": {
+				Name:        "order_status",
+				Content:     "Status from the manifest.",
+				ProjectName: "demo",
+				URI:         filepath.Join(root, "models/docs.md"),
+				Range: lsp.Range{
+					Start: lsp.Position{Line: 0, Character: 8},
+					End:   lsp.Position{Line: 0, Character: 20},
+				},
+			},
+		},
+	}
+	if !reflect.DeepEqual(state.DbtContext.DocsDetailMap, expectedDocs) {
+		t.Errorf("expected docs %v,\n\ngot %v", expectedDocs, state.DbtContext.DocsDetailMap)
+	}
+
+	expectedTests := map[string]string{
+		"assert_positive_orders": filepath.Join(root, "tests/assert_positive_orders.sql"),
+	}
+	if !reflect.DeepEqual(state.DbtContext.SingularTestMap, expectedTests) {
+		t.Errorf("expected singular tests %v, got %v", expectedTests, state.DbtContext.SingularTestMap)
+	}
+
+	exposure := state.DbtContext.SemanticDetailMap[ExposureKind]["weekly_report"]
+	if exposure.Description != "Rendered by dbt" || exposure.Type != "dashboard" || exposure.Range.Start.Line != 12 {
+		t.Errorf("expected the exposure from the manifest with its yaml position, got %+v", exposure)
+	}
+}
+
+func TestManifestIsFreshIgnoresInstalledPackageFiles(t *testing.T) {
+	root := testutils.CopyTestdata(t, "manifest_project")
+	for _, file := range []string{"dbt_packages/shared/models/shared.sql", "packages.yml"} {
+		if err := os.MkdirAll(filepath.Dir(filepath.Join(root, file)), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(root, file), []byte(""), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	setManifestTime(t, root, time.Hour)
+
+	projYaml := parseDbtProjectYaml(root)
+	path := manifestPath(root, projYaml)
+	touch := func(file string) {
+		later := time.Now().Add(2 * time.Hour)
+		if err := os.Chtimes(filepath.Join(root, file), later, later); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	touch("dbt_packages/shared/models/shared.sql")
+	if !manifestIsFresh(path, root, projYaml) {
+		t.Fatal("expected installed package files not to be checked")
+	}
+
+	touch("packages.yml")
+	if manifestIsFresh(path, root, projYaml) {
+		t.Fatal("expected a change to packages.yml to make the manifest stale")
+	}
+}
+
+func TestRefreshDbtContextStaleManifest(t *testing.T) {
+	root := testutils.CopyTestdata(t, "manifest_project")
+	setManifestTime(t, root, -time.Hour)
+
+	state := NewState()
+	state.refreshDbtContext(root)
+
+	orders := state.DbtContext.ModelDetailMap["orders"]
+	if orders.Description != "From yaml" {
+		t.Fatalf("expected the filesystem description, got %q", orders.Description)
+	}
+	if _, ok := state.DbtContext.ModelDetailMap["customers_v1"]; !ok {
+		t.Fatalf("expected filesystem model names, got %v", state.DbtContext.ModelDetailMap)
+	}
+}
+
+func TestManifestIsFreshChecksSnapshotsAndTests(t *testing.T) {
+	for _, dir := range []string{"snapshots", "tests"} {
+		t.Run(dir, func(t *testing.T) {
+			root := testutils.CopyTestdata(t, "manifest_project")
+			if err := os.MkdirAll(filepath.Join(root, dir), 0o755); err != nil {
+				t.Fatal(err)
+			}
+			setManifestTime(t, root, time.Hour)
+
+			projYaml := parseDbtProjectYaml(root)
+			path := manifestPath(root, projYaml)
+			if !manifestIsFresh(path, root, projYaml) {
+				t.Fatal("expected the manifest to be fresh")
+			}
+
+			file := filepath.Join(root, dir, "changed.sql")
+			if err := os.WriteFile(file, []byte("select 1"), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			later := time.Now().Add(2 * time.Hour)
+			if err := os.Chtimes(file, later, later); err != nil {
+				t.Fatal(err)
+			}
+			if manifestIsFresh(path, root, projYaml) {
+				t.Fatalf("expected a change in %s to make the manifest stale", dir)
+			}
+		})
+	}
+}
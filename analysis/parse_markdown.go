@@ -2,21 +2,27 @@ package analysis
 
 import (
 	"os"
+	"path/filepath"
 	"regexp"
 	"strings"
 
+	"github.com/j-clemons/dbt-language-server/lsp"
 	"github.com/j-clemons/dbt-language-server/util"
 )
 
 type Docs struct {
-	Name    string
-	Content string
+	Name        string
+	Content     string
+	ProjectName Package
+	URI         string
+	Range       lsp.Range
 }
 
-func getDocsFiles(dbtProjectYaml DbtProjectYaml) []string {
+func getDocsFiles(projectRoot string, dbtProjectYaml DbtProjectYaml) []string {
 	docsFiles := []string{}
 
-	for _, path := range dbtProjectYaml.DocsPaths.Value {
+	for _, p := range dbtProjectYaml.DocsPaths.Value {
+		path := filepath.Join(projectRoot, p)
 		_, err := os.ReadDir(path)
 		if err != nil {
 			continue
@@ -30,17 +36,23 @@ func getDocsFiles(dbtProjectYaml DbtProjectYaml) []string {
 	return docsFiles
 }
 
-func getDocsFileContents(docsFileStr string) []Docs {
+func getDocsFileContents(docsFileStr string, fileUri string) []Docs {
 	docs := []Docs{}
 
-	re := regexp.MustCompile(`(?s){%-{0,1}\s*docs\s+([a-zA-z]+)\s*-{0,1}%}(.*){%-{0,1}\s*enddocs\s*%}`)
-	docsMatches := re.FindAllStringSubmatch(docsFileStr, -1)
+	re := regexp.MustCompile(`(?s){%-{0,1}\s*docs\s+([a-zA-z]+)\s*-{0,1}%}(.*?){%-{0,1}\s*enddocs\s*%}`)
+	docsMatches := re.FindAllStringSubmatchIndex(docsFileStr, -1)
 	for _, d := range docsMatches {
+		line, column := util.GetLineAndColumn(docsFileStr, d[2])
 		docs = append(
 			docs,
 			Docs{
-				Name:    d[1],
-				Content: strings.TrimSpace(d[2]),
+				Name:    docsFileStr[d[2]:d[3]],
+				Content: strings.TrimSpace(docsFileStr[d[4]:d[5]]),
+				URI:     fileUri,
+				Range: lsp.Range{
+					Start: lsp.Position{Line: line, Character: column},
+					End:   lsp.Position{Line: line, Character: column + d[3] - d[2]},
+				},
 			},
 		)
 	}
@@ -63,7 +75,35 @@ func processDocsFiles(docsFilesUri []string) map[string]Docs {
 		if err != nil {
 			continue
 		}
-		docs = append(docs, getDocsFileContents(docsContents)...)
+		docs = append(docs, getDocsFileContents(docsContents, docsFile)...)
 	}
 	return makeDocsMap(docs)
 }
+
+func (s *State) getDocsDetails() map[Package]map[string]Docs {
+	packageDocsMap := make(map[Package]map[string]Docs)
+
+	processList := []ProjectDetails{
+		{
+			RootPath:       s.DbtContext.ProjectRoot,
+			DbtProjectYaml: s.DbtContext.ProjectYaml,
+		},
+	}
+	processList = append(processList, getPackageModelDetails(s.DbtContext.ProjectRoot, s.DbtContext.ProjectYaml)...)
+
+	for _, p := range processList {
+		projectName := Package(p.DbtProjectYaml.ProjectName.Value)
+		docsMap := processDocsFiles(getDocsFiles(p.RootPath, p.DbtProjectYaml))
+		if len(docsMap) == 0 {
+			continue
+		}
+
+		packageDocsMap[projectName] = make(map[string]Docs)
+		for k, d := range docsMap {
+			d.ProjectName = projectName
+			packageDocsMap[projectName][k] = d
+		}
+	}
+
+	return packageDocsMap
+}
@@ -2,6 +2,8 @@ package analysis
 
 import (
 	"testing"
+
+	"github.com/j-clemons/dbt-language-server/lsp"
 )
 
 func TestGetDocsFileContents(t *testing.T) {
@@ -10,6 +12,10 @@ func TestGetDocsFileContents(t *testing.T) {
 
 This table contains clickstream events from the marketing website.
 
+{% enddocs %}
+
+{% docs table_orders %}
+One row per order.
 {% enddocs %}
 `
 	testCases := []struct {
@@ -24,6 +30,20 @@ This table contains clickstream events from the marketing website.
 				Docs{
 					Name:    "table_events",
 					Content: "This table contains clickstream events from the marketing website.",
+					URI:     "/path/to/docs.md",
+					Range: lsp.Range{
+						Start: lsp.Position{Line: 1, Character: 8},
+						End:   lsp.Position{Line: 1, Character: 20},
+					},
+				},
+				Docs{
+					Name:    "table_orders",
+					Content: "One row per order.",
+					URI:     "/path/to/docs.md",
+					Range: lsp.Range{
+						Start: lsp.Position{Line: 7, Character: 8},
+						End:   lsp.Position{Line: 7, Character: 20},
+					},
 				},
 			},
 		},
@@ -31,7 +51,10 @@ This table contains clickstream events from the marketing website.
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			result := getDocsFileContents(tc.fileStr)
+			result := getDocsFileContents(tc.fileStr, "/path/to/docs.md")
+			if len(result) != len(tc.expected) {
+				t.Fatalf("expected %d docs, got %d: %v", len(tc.expected), len(result), result)
+			}
 			for i, e := range tc.expected {
 				if e != result[i] {
 					t.Errorf("input: %v; got: %v; want: %v",
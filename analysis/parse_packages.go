@@ -3,8 +3,65 @@ package analysis
 import (
 	"os"
 	"path/filepath"
+
+	"github.com/j-clemons/dbt-language-server/util"
+	"gopkg.in/yaml.v3"
 )
 
+// PackagesYaml holds the packages a project installs with dbt deps, from
+// packages.yml or dependencies.yml.
+type PackagesYaml struct {
+	Packages []PackageSpec `yaml:"packages"`
+}
+
+// PackageSpec is one installed package: a hub package, a git repository or
+// a local directory.
+type PackageSpec struct {
+	Package string `yaml:"package"`
+	Git     string `yaml:"git"`
+	Local   string `yaml:"local"`
+}
+
+func parsePackagesYaml(projectRoot string) PackagesYaml {
+	packages := PackagesYaml{}
+	for _, name := range []string{"packages.yml", "dependencies.yml"} {
+		contents, err := os.ReadFile(filepath.Join(projectRoot, name))
+		if err != nil {
+			continue
+		}
+		var file PackagesYaml
+		if err := yaml.Unmarshal([]byte(util.ResolveEnvVars(string(contents))), &file); err == nil {
+			packages.Packages = append(packages.Packages, file.Packages...)
+		}
+	}
+	return packages
+}
+
+// localPackages maps the install directory of each local package to the
+// directory it's installed from. dbt deps links, or copies, a local package
+// into the packages install path under its project name.
+func localPackages(projectRoot string, projYaml DbtProjectYaml) map[string]string {
+	locals := map[string]string{}
+	if projYaml.PackagesInstallPath.Value == "" {
+		return locals
+	}
+	for _, spec := range parsePackagesYaml(projectRoot).Packages {
+		if spec.Local == "" {
+			continue
+		}
+		source := spec.Local
+		if !filepath.IsAbs(source) {
+			source = filepath.Join(projectRoot, source)
+		}
+		name := parseDbtProjectYaml(source).ProjectName.Value
+		if name == "" {
+			continue
+		}
+		locals[filepath.Join(projectRoot, projYaml.PackagesInstallPath.Value, name)] = source
+	}
+	return locals
+}
+
 func getPackageRootPaths(projectRoot string, projYaml DbtProjectYaml) []string {
 	packagePaths := []string{}
 	if projYaml.PackagesInstallPath.Value == "" {
@@ -14,8 +71,10 @@ func getPackageRootPaths(projectRoot string, projYaml DbtProjectYaml) []string {
 
 	files, _ := os.ReadDir(projectPackagePath)
 	for _, file := range files {
-		if file.IsDir() {
-			packagePaths = append(packagePaths, filepath.Join(projectPackagePath, file.Name()))
+		// local packages are usually linked rather than copied
+		path := filepath.Join(projectPackagePath, file.Name())
+		if info, err := os.Stat(path); err == nil && info.IsDir() {
+			packagePaths = append(packagePaths, path)
 		}
 	}
 	return packagePaths
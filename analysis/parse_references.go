@@ -0,0 +1,186 @@
+package analysis
+
+import (
+	"path/filepath"
+
+	"github.com/j-clemons/dbt-language-server/analysis/parser"
+	"github.com/j-clemons/dbt-language-server/lsp"
+	"github.com/j-clemons/dbt-language-server/util"
+)
+
+// ReferenceKey identifies the dbt resource a token points at. Package holds
+// the macro package for MACRO keys, the source name for SOURCE_TABLE keys and
+// the package of a ref('package', 'model') for REF keys, which may also pin
+// a Version.
+type ReferenceKey struct {
+	Type    parser.TokenType
+	Package string
+	Name    string
+	Version string
+}
+
+// indexKey is the key a reference is indexed under. Refs to a model are
+// grouped whichever package or version argument they pass, and told apart
+// by the model they resolve to.
+func (k ReferenceKey) indexKey() ReferenceKey {
+	if k.Type == parser.REF {
+		return ReferenceKey{Type: k.Type, Name: k.Name}
+	}
+	return k
+}
+
+type Reference struct {
+	Key   ReferenceKey
+	URI   string
+	Range lsp.Range
+	// Qualified is set on macro calls that name their package, such as
+	// dbt_utils.star(). Unqualified calls may be builtins or dispatched.
+	Qualified bool
+}
+
+// isQuotedArgument reports whether the token is a quoted function argument.
+// The lexer gives the ref, source and var keywords the same token type as
+// their arguments, so this separates `ref` from the model name in ref('x').
+func isQuotedArgument(tokenLL *parser.TokenLL) bool {
+	if tokenLL.PrevToken == nil {
+		return false
+	}
+	prevType := tokenLL.PrevToken.Token.Type
+	return prevType == parser.SINGLE_QUOTE || prevType == parser.DOUBLE_QUOTE
+}
+
+func referenceKeyFromToken(tokenLL *parser.TokenLL, projectName string) (ReferenceKey, bool) {
+	token := tokenLL.Token
+
+	switch token.Type {
+	case parser.REF:
+		if !isQuotedArgument(tokenLL) {
+			return ReferenceKey{}, false
+		}
+		_, packageName := tokenLL.TokenLookbackMatch(parser.PACKAGE, 4)
+		return ReferenceKey{Type: token.Type, Package: packageName, Name: token.Literal, Version: refVersion(tokenLL)}, true
+	case parser.SOURCE, parser.VAR, parser.METRIC:
+		if !isQuotedArgument(tokenLL) {
+			return ReferenceKey{}, false
+		}
+		return ReferenceKey{Type: token.Type, Name: token.Literal}, true
+	case parser.SOURCE_TABLE:
+		match, sourceName := tokenLL.TokenLookbackMatch(parser.SOURCE, 4)
+		if !match {
+			return ReferenceKey{}, false
+		}
+		return ReferenceKey{Type: token.Type, Package: sourceName, Name: token.Literal}, true
+	case parser.MACRO:
+		packageName := projectName
+		match, tokenLiteral := tokenLL.TokenLookbackMatch(parser.PACKAGE, 2)
+		if match {
+			packageName = tokenLiteral
+		}
+		return ReferenceKey{Type: token.Type, Package: packageName, Name: token.Literal}, true
+	}
+
+	return ReferenceKey{}, false
+}
+
+// refVersion returns the v= or version= argument following the model name
+// of a ref().
+func refVersion(tokenLL *parser.TokenLL) string {
+	next := tokenLL.NextToken
+	for i := 0; i < 6 && next != nil && next.Token.Type != parser.RPAREN; i++ {
+		if next.Token.Type == parser.VERSION {
+			return next.Token.Literal
+		}
+		next = next.NextToken
+	}
+	return ""
+}
+
+func tokenRange(token parser.Token) lsp.Range {
+	return lsp.Range{
+		Start: lsp.Position{
+			Line:      token.Line,
+			Character: token.Column,
+		},
+		End: lsp.Position{
+			Line:      token.Line,
+			Character: token.Column + len(token.Literal),
+		},
+	}
+}
+
+func referenceFromToken(tokenLL *parser.TokenLL, uri string, projectName string) (Reference, bool) {
+	key, ok := referenceKeyFromToken(tokenLL, projectName)
+	if !ok {
+		return Reference{}, false
+	}
+	reference := Reference{
+		Key:   key,
+		URI:   uri,
+		Range: tokenRange(tokenLL.Token),
+	}
+	if key.Type == parser.MACRO {
+		reference.Qualified, _ = tokenLL.TokenLookbackMatch(parser.PACKAGE, 2)
+	}
+	return reference, true
+}
+
+func getReferencesFromTokens(tokens []parser.TokenLL, uri string, projectName string) []Reference {
+	references := []Reference{}
+	for i := range tokens {
+		if reference, ok := referenceFromToken(&tokens[i], uri, projectName); ok {
+			references = append(references, reference)
+		}
+	}
+	return references
+}
+
+func getProjectSqlFiles(projectRoot string, projYaml DbtProjectYaml) []string {
+	paths := []string{}
+	paths = append(paths, projYaml.ModelPaths.Value...)
+	paths = append(paths, projYaml.MacroPaths.Value...)
+	paths = append(paths, projYaml.SnapshotPaths.Value...)
+	paths = append(paths, projYaml.TestPaths.Value...)
+
+	files := []string{}
+	for _, p := range paths {
+		sqlFiles, err := util.WalkFilepath(filepath.Join(projectRoot, p), ".sql")
+		if err != nil {
+			continue
+		}
+		files = append(files, sqlFiles...)
+	}
+	return files
+}
+
+func (s *State) getReferenceIndex() map[ReferenceKey][]Reference {
+	referenceIndex := make(map[ReferenceKey][]Reference)
+
+	processList := []ProjectDetails{
+		{
+			RootPath:       s.DbtContext.ProjectRoot,
+			DbtProjectYaml: s.DbtContext.ProjectYaml,
+		},
+	}
+	processList = append(processList, getPackageModelDetails(s.DbtContext.ProjectRoot, s.DbtContext.ProjectYaml)...)
+
+	for _, p := range processList {
+		for _, file := range getProjectSqlFiles(p.RootPath, p.DbtProjectYaml) {
+			fileContents, err := util.ReadFileContents(file)
+			if err != nil {
+				continue
+			}
+
+			tokens := parser.Parse(fileContents, s.DbtContext.Dialect).CreateTokenIndex().Tokens()
+			for _, r := range getReferencesFromTokens(tokens, file, p.DbtProjectYaml.ProjectName.Value) {
+				referenceIndex[r.Key.indexKey()] = append(referenceIndex[r.Key.indexKey()], r)
+			}
+		}
+		for _, file := range propertiesFiles(p.RootPath, p.DbtProjectYaml) {
+			for _, r := range semanticReferences(file, p.DbtProjectYaml.ProjectName.Value, s.DbtContext.Dialect) {
+				referenceIndex[r.Key.indexKey()] = append(referenceIndex[r.Key.indexKey()], r)
+			}
+		}
+	}
+
+	return referenceIndex
+}
@@ -0,0 +1,222 @@
+package analysis
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/j-clemons/dbt-language-server/analysis/parser"
+	"github.com/j-clemons/dbt-language-server/docs"
+	"github.com/j-clemons/dbt-language-server/lsp"
+	"github.com/j-clemons/dbt-language-server/util"
+	"gopkg.in/yaml.v3"
+)
+
+// SemanticKind is the properties file key a SemanticNode is defined under.
+type SemanticKind string
+
+const (
+	ExposureKind      SemanticKind = "exposures"
+	MetricKind        SemanticKind = "metrics"
+	SemanticModelKind SemanticKind = "semantic_models"
+	SavedQueryKind    SemanticKind = "saved_queries"
+	GroupKind         SemanticKind = "groups"
+)
+
+// SemanticNode is an exposure, metric, semantic model, saved query or group.
+type SemanticNode struct {
+	Name        string
+	Kind        SemanticKind
+	ProjectName string
+	Label       string
+	// Type is the exposure or metric type.
+	Type        string
+	Description string
+	URI         string
+	Range       lsp.Range
+	// Owner is the name, or email, of a group's owner.
+	Owner string
+	// Model, Entities, Dimensions and Measures are set for semantic models.
+	Model      string
+	Entities   []SemanticElement
+	Dimensions []SemanticElement
+	Measures   []SemanticElement
+}
+
+// SemanticElement is an entity, dimension or measure of a semantic model.
+type SemanticElement struct {
+	Name        string
+	Type        string
+	Description string
+	Range       lsp.Range
+}
+
+func semanticElements(props []SemanticElementProperties) []SemanticElement {
+	elements := []SemanticElement{}
+	for _, p := range props {
+		elementType := p.Type.Value
+		if elementType == "" {
+			elementType = p.Agg.Value
+		}
+		elements = append(elements, SemanticElement{
+			Name:        p.Name.Value,
+			Type:        elementType,
+			Description: p.Description.Value,
+			Range:       lsp.Range{Start: p.Name.Position, End: p.Name.Position},
+		})
+	}
+	return elements
+}
+
+// propertiesFiles lists the YAML files in a project's model paths.
+func propertiesFiles(projectRoot string, projYaml DbtProjectYaml) []string {
+	files := []string{}
+	for _, path := range projYaml.ModelPaths.Value {
+		dir := filepath.Join(projectRoot, path)
+		if _, err := os.ReadDir(dir); err != nil {
+			continue
+		}
+		ymlFiles, _ := util.WalkFilepath(dir, ".yml")
+		files = append(files, ymlFiles...)
+	}
+	return files
+}
+
+func newSemanticNodeMap() map[SemanticKind]map[string]SemanticNode {
+	return map[SemanticKind]map[string]SemanticNode{
+		ExposureKind:      {},
+		MetricKind:        {},
+		SemanticModelKind: {},
+		SavedQueryKind:    {},
+		GroupKind:         {},
+	}
+}
+
+func parseYamlSemanticNodes(projectRoot string, projYaml DbtProjectYaml) map[SemanticKind]map[string]SemanticNode {
+	nodeMap := newSemanticNodeMap()
+
+	docsMap := processDocsFiles(getDocsFiles(projectRoot, projYaml))
+	for _, file := range propertiesFiles(projectRoot, projYaml) {
+		addFileSemanticNodes(nodeMap, file, projYaml.ProjectName.Value, docsMap)
+	}
+	return nodeMap
+}
+
+// addFileSemanticNodes adds the semantic nodes defined in a properties file
+// to nodeMap.
+func addFileSemanticNodes(nodeMap map[SemanticKind]map[string]SemanticNode, file string, projectName string, docsMap map[string]Docs) {
+	props := parsePropertiesYamlFile(file)
+	add := func(kind SemanticKind, name AnnotatedField[string], node SemanticNode) {
+		node.Name = name.Value
+		node.Kind = kind
+		node.ProjectName = projectName
+		node.Description = replaceDescriptionDocsBlocks(node.Description, docsMap)
+		node.URI = file
+		node.Range = lsp.Range{
+			Start: name.Position,
+			End: lsp.Position{
+				Line:      name.Position.Line,
+				Character: name.Position.Character + len(name.Value),
+			},
+		}
+		nodeMap[kind][name.Value] = node
+	}
+
+	for _, e := range props.Exposures {
+		add(ExposureKind, e.Name, SemanticNode{Label: e.Label.Value, Type: e.Type.Value, Description: e.Description.Value})
+	}
+	for _, m := range props.Metrics {
+		add(MetricKind, m.Name, SemanticNode{Label: m.Label.Value, Type: m.Type.Value, Description: m.Description.Value})
+	}
+	for _, sm := range props.SemanticModels {
+		add(SemanticModelKind, sm.Name, SemanticNode{
+			Description: sm.Description.Value,
+			Model:       sm.Model.Value,
+			Entities:    semanticElements(sm.Entities),
+			Dimensions:  semanticElements(sm.Dimensions),
+			Measures:    semanticElements(sm.Measures),
+		})
+	}
+	for _, sq := range props.SavedQueries {
+		add(SavedQueryKind, sq.Name, SemanticNode{Label: sq.Label.Value, Description: sq.Description.Value})
+	}
+	for _, g := range props.Groups {
+		owner := g.Owner.Name.Value
+		if owner == "" {
+			owner = g.Owner.Email.Value
+		}
+		add(GroupKind, g.Name, SemanticNode{Description: g.Description.Value, Owner: owner})
+	}
+}
+
+func (s *State) getSemanticDetails() map[SemanticKind]map[string]SemanticNode {
+	nodeMap := make(map[SemanticKind]map[string]SemanticNode)
+
+	processList := []ProjectDetails{
+		{
+			RootPath:       s.DbtContext.ProjectRoot,
+			DbtProjectYaml: s.DbtContext.ProjectYaml,
+		},
+	}
+	processList = append(processList, getPackageModelDetails(s.DbtContext.ProjectRoot, s.DbtContext.ProjectYaml)...)
+
+	for _, p := range processList {
+		for kind, nodes := range parseYamlSemanticNodes(p.RootPath, p.DbtProjectYaml) {
+			if nodeMap[kind] == nil {
+				nodeMap[kind] = make(map[string]SemanticNode)
+			}
+			for name, node := range nodes {
+				nodeMap[kind][name] = node
+			}
+		}
+	}
+	return nodeMap
+}
+
+// semanticReferences returns the ref(), source() and metric() calls in the
+// exposures, metrics, semantic models and saved queries of a properties
+// file, e.g. an exposure's depends_on.
+func semanticReferences(file string, projectName string, dialect docs.Dialect) []Reference {
+	text, err := util.ReadFileContents(file)
+	if err != nil {
+		return nil
+	}
+	var root yaml.Node
+	if err := yaml.Unmarshal([]byte(text), &root); err != nil || len(root.Content) == 0 || root.Content[0].Kind != yaml.MappingNode {
+		return nil
+	}
+
+	// each top-level key spans the lines up to the next one
+	type span struct{ start, end int }
+	spans := []span{}
+	keys := root.Content[0].Content
+	for i := 0; i+1 < len(keys); i += 2 {
+		switch SemanticKind(keys[i].Value) {
+		case ExposureKind, MetricKind, SemanticModelKind, SavedQueryKind:
+		default:
+			continue
+		}
+		end := -1
+		if i+2 < len(keys) {
+			end = keys[i+2].Line - 1
+		}
+		spans = append(spans, span{start: keys[i].Line - 1, end: end})
+	}
+	if len(spans) == 0 {
+		return nil
+	}
+
+	references := []Reference{}
+	tokens := parser.Parse(text, dialect).CreateTokenIndex().Tokens()
+	for _, r := range getReferencesFromTokens(tokens, file, projectName) {
+		if r.Key.Type == parser.MACRO {
+			continue
+		}
+		for _, sp := range spans {
+			if r.Range.Start.Line >= sp.start && (sp.end == -1 || r.Range.Start.Line < sp.end) {
+				references = append(references, r)
+				break
+			}
+		}
+	}
+	return references
+}
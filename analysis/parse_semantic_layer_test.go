@@ -0,0 +1,156 @@
+package analysis
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/j-clemons/dbt-language-server/analysis/parser"
+	"github.com/j-clemons/dbt-language-server/lsp"
+)
+
+func TestGetSemanticDetails(t *testing.T) {
+	state, testdataRoot := yamlTestState(t)
+	semanticFile := filepath.Join(testdataRoot, "models/semantic.yml")
+
+	revenue := state.DbtContext.SemanticDetailMap[MetricKind]["revenue"]
+	expected := SemanticNode{
+		Name:        "revenue",
+		Kind:        MetricKind,
+		ProjectName: "jaffle_shop",
+		Label:       "Revenue",
+		Type:        "simple",
+		Description: "Total order amount",
+		URI:         semanticFile,
+		Range: lsp.Range{
+			Start: lsp.Position{Line: 32, Character: 10},
+			End:   lsp.Position{Line: 32, Character: 17},
+		},
+	}
+	if !reflect.DeepEqual(revenue, expected) {
+		t.Errorf("expected %#v,\n\ngot %#v", expected, revenue)
+	}
+
+	orders := state.DbtContext.SemanticDetailMap[SemanticModelKind]["orders"]
+	if orders.Model != "ref('orders')" || primaryEntity(orders) != "order" {
+		t.Errorf("unexpected semantic model %#v", orders)
+	}
+	for kind, name := range map[SemanticKind]string{
+		ExposureKind:   "weekly_kpis",
+		SavedQueryKind: "revenue_by_status",
+		GroupKind:      "finance",
+	} {
+		if _, ok := state.DbtContext.SemanticDetailMap[kind][name]; !ok {
+			t.Errorf("expected %s %q to be indexed", kind, name)
+		}
+	}
+
+	// refs in the semantic layer count as references, but the
+	// relationships test in schema.yml stays out of the index
+	refs := map[ReferenceKey]bool{}
+	for key, references := range state.DbtContext.ReferenceIndex {
+		for _, r := range references {
+			if r.URI == semanticFile {
+				refs[key] = true
+			}
+			if r.URI == filepath.Join(testdataRoot, "models/schema.yml") {
+				t.Errorf("unexpected reference %v from schema.yml", r)
+			}
+		}
+	}
+	for _, key := range []ReferenceKey{
+		{Type: parser.REF, Name: "orders"},
+		{Type: parser.REF, Name: "customers"},
+		{Type: parser.METRIC, Name: "revenue"},
+	} {
+		if !refs[key] {
+			t.Errorf("expected %v to be referenced from semantic.yml, got %v", key, refs)
+		}
+	}
+}
+
+func TestMetricDefinitionAndHover(t *testing.T) {
+	state, testdataRoot := yamlTestState(t)
+	uri := "file://" + filepath.Join(testdataRoot, "models/new_model.sql")
+	state.parseDocument(uri, "select * from {{ metric('revenue') }}\n")
+
+	position := lsp.Position{Line: 0, Character: 27}
+	expected := lsp.Location{
+		URI: "file://" + filepath.Join(testdataRoot, "models/semantic.yml"),
+		Range: lsp.Range{
+			Start: lsp.Position{Line: 32, Character: 10},
+			End:   lsp.Position{Line: 32, Character: 17},
+		},
+	}
+	if actual := state.Definition(1, uri, position).Result; actual != expected {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+
+	hover := state.Hover(2, uri, position).Result.Contents
+	if hover != "**Revenue** (simple)\n\nTotal order amount" {
+		t.Errorf("unexpected hover %q", hover)
+	}
+
+	items := state.TextDocumentCompletion(3, uri, lsp.Position{Line: 0, Character: 25}).Result
+	if labels := completionLabels(items); !reflect.DeepEqual(labels, []string{"completed_orders", "revenue"}) {
+		t.Errorf("expected metric completion, got %v", labels)
+	}
+}
+
+func TestSemanticYamlCompletion(t *testing.T) {
+	state, testdataRoot := yamlTestState(t)
+	uri := "file://" + filepath.Join(testdataRoot, "models/metrics.yml")
+	state.parseDocument(uri, `version: 2
+
+semantic_models:
+  - name: orders
+    defaults:
+      agg_time_dimension:
+
+metrics:
+  - name: aov
+    type: ratio
+    type_params:
+      numerator:
+      denominator:
+        name:
+  - name: big_orders
+    type: simple
+    type_params:
+      measure:
+    filter: "{{ Entity('
+  - name: by_day
+    filter: "{{ TimeDimension('
+
+saved_queries:
+  - name: recent
+    query_params:
+      group_by:
+        - "Dimension('
+      metrics:
+        -
+`)
+
+	tests := []struct {
+		name     string
+		position lsp.Position
+		expected []string
+	}{
+		{"agg time dimension", lsp.Position{Line: 5, Character: 25}, []string{"order_date"}},
+		{"ratio numerator", lsp.Position{Line: 11, Character: 16}, []string{"completed_orders", "revenue"}},
+		{"ratio denominator", lsp.Position{Line: 13, Character: 13}, []string{"completed_orders", "revenue"}},
+		{"measure", lsp.Position{Line: 17, Character: 14}, []string{"order_total", "order_count"}},
+		{"entity", lsp.Position{Line: 18, Character: 24}, []string{"order", "customer"}},
+		{"time dimension", lsp.Position{Line: 20, Character: 31}, []string{"metric_time", "order__order_date"}},
+		{"dimension", lsp.Position{Line: 26, Character: 22}, []string{"order__order_date", "order__status"}},
+		{"saved query metrics", lsp.Position{Line: 28, Character: 9}, []string{"completed_orders", "revenue"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			items := state.TextDocumentCompletion(1, uri, tt.position).Result
+			if labels := completionLabels(items); !reflect.DeepEqual(labels, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, labels)
+			}
+		})
+	}
+}
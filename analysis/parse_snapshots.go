@@ -0,0 +1,115 @@
+package analysis
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"github.com/j-clemons/dbt-language-server/lsp"
+	"github.com/j-clemons/dbt-language-server/util"
+)
+
+var snapshotBlockRegex = regexp.MustCompile(`\{%-?\s*snapshot\s+(\w+)\s*-?%\}`)
+
+// snapshotBlocks returns the range of the name of each `{% snapshot name %}`
+// block in text.
+func snapshotBlocks(text string) map[string]lsp.Range {
+	blocks := make(map[string]lsp.Range)
+	for _, m := range snapshotBlockRegex.FindAllStringSubmatchIndex(text, -1) {
+		line, column := util.GetLineAndColumn(text, m[2])
+		blocks[text[m[2]:m[3]]] = lsp.Range{
+			Start: lsp.Position{Line: line, Character: column},
+			End:   lsp.Position{Line: line, Character: column + m[3] - m[2]},
+		}
+	}
+	return blocks
+}
+
+// snapshotRange finds where the file at path defines a snapshot, either as
+// a SQL block or a YAML entry with a relation.
+func snapshotRange(path string, name string) lsp.Range {
+	if filepath.Ext(path) == ".sql" {
+		text, err := util.ReadFileContents(path)
+		if err != nil {
+			return lsp.Range{}
+		}
+		return snapshotBlocks(text)[name]
+	}
+	for _, snapshot := range parsePropertiesYamlFile(path).Snapshots {
+		if snapshot.Name.Value == name {
+			return lsp.Range{Start: snapshot.Name.Position, End: snapshot.Name.Position}
+		}
+	}
+	return lsp.Range{}
+}
+
+// createSnapshotMap indexes the snapshots in a project's snapshot paths:
+// `{% snapshot %}` blocks in SQL files, and YAML `snapshots:` entries with a
+// relation. Entries without one describe a SQL snapshot.
+func createSnapshotMap(projectRoot string, projYaml DbtProjectYaml) map[string]ModelDetails {
+	snapshotMap := make(map[string]ModelDetails)
+	properties := make(map[string]ModelDetails)
+
+	for _, path := range projYaml.SnapshotPaths.Value {
+		dir := filepath.Join(projectRoot, path)
+		if _, err := os.ReadDir(dir); err != nil {
+			continue
+		}
+
+		sqlFiles, _ := util.WalkFilepath(dir, ".sql")
+		for _, file := range sqlFiles {
+			text, err := util.ReadFileContents(file)
+			if err != nil {
+				continue
+			}
+			for name, rng := range snapshotBlocks(text) {
+				snapshotMap[name] = ModelDetails{
+					URI:         file,
+					Description: "Snapshot",
+					Range:       rng,
+					Snapshot:    true,
+				}
+			}
+		}
+
+		ymlFiles, _ := util.WalkFilepath(dir, ".yml")
+		for _, file := range ymlFiles {
+			for _, snapshot := range parsePropertiesYamlFile(file).Snapshots {
+				rng := lsp.Range{Start: snapshot.Name.Position, End: snapshot.Name.Position}
+				details := ModelDetails{
+					Description: snapshot.Description.Value,
+					SchemaURI:   file,
+					SchemaRange: rng,
+					Snapshot:    true,
+				}
+				if snapshot.Relation.Value != "" {
+					details.URI = file
+					details.Range = rng
+				}
+				properties[snapshot.Name.Value] = details
+			}
+		}
+	}
+
+	for name, props := range properties {
+		snapshot, ok := snapshotMap[name]
+		switch {
+		case props.URI != "":
+			snapshot = props
+		case !ok:
+			// documents a snapshot that doesn't exist
+			continue
+		}
+		if props.Description != "" {
+			snapshot.Description = props.Description
+		}
+		if snapshot.Description == "" {
+			snapshot.Description = "Snapshot"
+		}
+		snapshot.SchemaURI = props.SchemaURI
+		snapshot.SchemaRange = props.SchemaRange
+		snapshotMap[name] = snapshot
+	}
+
+	return snapshotMap
+}
@@ -0,0 +1,90 @@
+package analysis
+
+import (
+	"path/filepath"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/j-clemons/dbt-language-server/lsp"
+	"github.com/j-clemons/dbt-language-server/testutils"
+)
+
+func TestSnapshotBlocks(t *testing.T) {
+	text := `{% snapshot orders_snapshot %}
+select 1
+{% endsnapshot %}
+
+{%- snapshot   payments_snapshot -%}
+select 2
+{%- endsnapshot -%}
+`
+	expected := map[string]lsp.Range{
+		"orders_snapshot": {
+			Start: lsp.Position{Line: 0, Character: 12},
+			End:   lsp.Position{Line: 0, Character: 27},
+		},
+		"payments_snapshot": {
+			Start: lsp.Position{Line: 4, Character: 15},
+			End:   lsp.Position{Line: 4, Character: 32},
+		},
+	}
+	if actual := snapshotBlocks(text); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+}
+
+func TestSnapshotRefs(t *testing.T) {
+	testdataRoot, err := testutils.GetTestdataPath("jaffle_shop_duckdb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	state := NewState()
+	state.refreshDbtContext(testdataRoot)
+
+	uri := "file://" + filepath.Join(testdataRoot, "models/new_model.sql")
+	state.parseDocument(uri, "select *\nfrom {{ ref('orders_snapshot') }}\njoin {{ ref('customers_snapshot') }}\n")
+
+	definition := state.Definition(1, uri, lsp.Position{Line: 1, Character: 16})
+	expected := lsp.Location{
+		URI: "file://" + filepath.Join(testdataRoot, "snapshots/orders_snapshot.sql"),
+		Range: lsp.Range{
+			Start: lsp.Position{Line: 0, Character: 12},
+			End:   lsp.Position{Line: 0, Character: 27},
+		},
+	}
+	if definition.Result != expected {
+		t.Errorf("expected %v, got %v", expected, definition.Result)
+	}
+
+	// YAML snapshots resolve to their entry
+	definition = state.Definition(2, uri, lsp.Position{Line: 2, Character: 16})
+	expected = lsp.Location{
+		URI: "file://" + filepath.Join(testdataRoot, "snapshots/snapshots.yml"),
+		Range: lsp.Range{
+			Start: lsp.Position{Line: 6, Character: 10},
+			End:   lsp.Position{Line: 6, Character: 10},
+		},
+	}
+	if definition.Result != expected {
+		t.Errorf("expected %v, got %v", expected, definition.Result)
+	}
+
+	hover := state.Hover(3, uri, lsp.Position{Line: 1, Character: 16})
+	if hover.Result.Contents != "History of order status changes" {
+		t.Errorf("expected snapshot description, got %q", hover.Result.Contents)
+	}
+
+	labels := completionLabels(getRefCompletionItems(state.DbtContext.ModelDetailMap, ""))
+	for _, name := range []string{"orders_snapshot", "customers_snapshot"} {
+		if !slices.Contains(labels, name) {
+			t.Errorf("expected %q in ref completion, got %v", name, labels)
+		}
+	}
+
+	for _, d := range state.Diagnostics(uri) {
+		if d.Code == "unresolved-ref" {
+			t.Errorf("expected snapshot refs to resolve, got %v", d)
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package analysis
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/j-clemons/dbt-language-server/util"
+)
+
+// genericTestDirs are where dbt looks for `{% test %}` blocks: the macro
+// paths and the generic folder of each test path.
+func genericTestDirs(projYaml DbtProjectYaml) []string {
+	dirs := append([]string{}, projYaml.MacroPaths.Value...)
+	for _, path := range projYaml.TestPaths.Value {
+		dirs = append(dirs, filepath.Join(path, "generic"))
+	}
+	return dirs
+}
+
+// parseGenericTests reads the `{% test name(model, column_name) %}` blocks of
+// a project. Tests are keyed by the name used in properties files, without
+// the test_ prefix dbt gives the compiled macro.
+func parseGenericTests(projectRoot string, projYaml DbtProjectYaml) []Macro {
+	tests := []Macro{}
+	for _, dir := range genericTestDirs(projYaml) {
+		files, err := util.WalkFilepath(filepath.Join(projectRoot, dir), ".sql")
+		if err != nil {
+			continue
+		}
+		for _, file := range files {
+			fileContents, err := util.ReadFileContents(file)
+			if err != nil {
+				continue
+			}
+			tests = append(tests, getJinjaBlocksFromFile("test", fileContents, file, projYaml)...)
+		}
+	}
+	return tests
+}
+
+// getGenericTestDetails indexes the generic tests of the project and its
+// packages. Macros named test_<name>, the older way of defining a generic
+// test, are included too.
+func (s *State) getGenericTestDetails(macroMap map[Package]map[string]Macro) map[Package]map[string]Macro {
+	processList := []ProjectDetails{
+		{
+			RootPath:       s.DbtContext.ProjectRoot,
+			DbtProjectYaml: s.DbtContext.ProjectYaml,
+		},
+	}
+	processList = append(processList, getPackageMacroDetails(s.DbtContext.ProjectRoot, s.DbtContext.ProjectYaml)...)
+
+	tests := []Macro{}
+	for _, p := range processList {
+		tests = append(tests, parseGenericTests(p.RootPath, p.DbtProjectYaml)...)
+	}
+
+	return genericTestMap(tests, macroMap)
+}
+
+// genericTestMap indexes tests by package, followed by the test_ macros of
+// macroMap that no test block shadows.
+func genericTestMap(tests []Macro, macroMap map[Package]map[string]Macro) map[Package]map[string]Macro {
+	testMap := make(map[Package]map[string]Macro)
+	add := func(test Macro) {
+		if testMap[test.ProjectName] == nil {
+			testMap[test.ProjectName] = make(map[string]Macro)
+		}
+		if _, exists := testMap[test.ProjectName][test.Name]; !exists {
+			testMap[test.ProjectName][test.Name] = test
+		}
+	}
+
+	for _, test := range tests {
+		add(test)
+	}
+
+	for _, macros := range macroMap {
+		for name, macro := range macros {
+			if test, ok := strings.CutPrefix(name, "test_"); ok {
+				macro.Name = test
+				add(macro)
+			}
+		}
+	}
+
+	return testMap
+}
+
+// getSingularTests maps each singular test, a SQL file in the project's test
+// paths outside their generic folders, to its path.
+func (s *State) getSingularTests() map[string]string {
+	tests := make(map[string]string)
+	for _, path := range s.DbtContext.ProjectYaml.TestPaths.Value {
+		dir := filepath.Join(s.DbtContext.ProjectRoot, path)
+		files, err := util.WalkFilepath(dir, ".sql")
+		if err != nil {
+			continue
+		}
+		for _, file := range files {
+			if rel, err := filepath.Rel(dir, file); err == nil && strings.HasPrefix(rel, "generic"+string(filepath.Separator)) {
+				continue
+			}
+			tests[strings.TrimSuffix(filepath.Base(file), ".sql")] = file
+		}
+	}
+	return tests
+}
+
+// genericTest resolves a test name from a properties file, such as
+// `unique_combination` or `dbt_utils.unique_combination`. Unqualified names
+// are looked up in the project before packages.
+func (s *State) genericTest(name string) (Macro, bool) {
+	if pkg, test, ok := strings.Cut(name, "."); ok {
+		macro, found := s.DbtContext.GenericTestMap[Package(pkg)][test]
+		return macro, found
+	}
+	projectName := Package(s.DbtContext.ProjectYaml.ProjectName.Value)
+	if macro, ok := s.DbtContext.GenericTestMap[projectName][name]; ok {
+		return macro, true
+	}
+	for _, tests := range s.DbtContext.GenericTestMap {
+		if macro, ok := tests[name]; ok {
+			return macro, true
+		}
+	}
+	return Macro{}, false
+}
@@ -0,0 +1,140 @@
+package analysis
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/j-clemons/dbt-language-server/analysis/parser"
+	"github.com/j-clemons/dbt-language-server/lsp"
+	"github.com/j-clemons/dbt-language-server/testutils"
+)
+
+func TestGetGenericTestDetails(t *testing.T) {
+	testdataRoot, err := testutils.GetTestdataPath("jaffle_shop_duckdb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	state := NewState()
+	state.refreshDbtContext(testdataRoot)
+
+	macroMap := map[Package]map[string]Macro{
+		"jaffle_shop": {
+			"test_legacy": {Name: "test_legacy", ProjectName: "jaffle_shop", URI: "legacy.sql"},
+			"other":       {Name: "other", ProjectName: "jaffle_shop", URI: "other.sql"},
+		},
+	}
+
+	expected := map[Package]map[string]Macro{
+		"jaffle_shop": {
+			"is_positive": {
+				Name:        "is_positive",
+				ProjectName: "jaffle_shop",
+				Description: "is_positive(model, column_name)",
+				Arguments:   []MacroArgument{{Name: "model"}, {Name: "column_name"}},
+				URI:         filepath.Join(testdataRoot, "tests/generic/is_positive.sql"),
+				Range: lsp.Range{
+					Start: lsp.Position{Line: 0, Character: 8},
+					End:   lsp.Position{Line: 0, Character: 39},
+				},
+			},
+			"legacy": {Name: "legacy", ProjectName: "jaffle_shop", URI: "legacy.sql"},
+		},
+		"jaffle_package": {
+			"not_empty": {
+				Name:        "not_empty",
+				ProjectName: "jaffle_package",
+				Description: "not_empty(model, column_name)",
+				Arguments:   []MacroArgument{{Name: "model"}, {Name: "column_name"}},
+				URI:         filepath.Join(testdataRoot, "dbt_packages/jaffle_package/macros/not_empty.sql"),
+				Range: lsp.Range{
+					Start: lsp.Position{Line: 0, Character: 8},
+					End:   lsp.Position{Line: 0, Character: 37},
+				},
+			},
+		},
+	}
+	if actual := state.getGenericTestDetails(macroMap); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected %#v,\n\ngot %#v", expected, actual)
+	}
+
+	expectedSingular := map[string]string{
+		"assert_payments_positive": filepath.Join(testdataRoot, "tests/assert_payments_positive.sql"),
+	}
+	if !reflect.DeepEqual(state.DbtContext.SingularTestMap, expectedSingular) {
+		t.Errorf("expected %v, got %v", expectedSingular, state.DbtContext.SingularTestMap)
+	}
+
+	// singular tests count as references to the models they select from
+	found := false
+	for key, refs := range state.DbtContext.ReferenceIndex {
+		for _, r := range refs {
+			if key.Type == parser.REF && key.Name == "stg_payments" && r.URI == expectedSingular["assert_payments_positive"] {
+				found = true
+			}
+		}
+	}
+	if !found {
+		t.Error("expected a reference to stg_payments from the singular test")
+	}
+}
+
+func TestYamlTestDefinition(t *testing.T) {
+	state, testdataRoot := yamlTestState(t)
+	uri := "file://" + filepath.Join(testdataRoot, "models/staging/tests.yml")
+	state.parseDocument(uri, `version: 2
+
+models:
+  - name: stg_payments
+    columns:
+      - name: amount
+        data_tests:
+          - is_positive
+          - jaffle_package.not_empty:
+              config:
+                severity: warn
+          - unique
+`)
+
+	tests := []struct {
+		name     string
+		position lsp.Position
+		expected lsp.Location
+	}{
+		{
+			name:     "project test",
+			position: lsp.Position{Line: 7, Character: 14},
+			expected: lsp.Location{
+				URI: "file://" + filepath.Join(testdataRoot, "tests/generic/is_positive.sql"),
+				Range: lsp.Range{
+					Start: lsp.Position{Line: 0, Character: 8},
+					End:   lsp.Position{Line: 0, Character: 39},
+				},
+			},
+		},
+		{
+			name:     "package test with arguments",
+			position: lsp.Position{Line: 8, Character: 30},
+			expected: lsp.Location{
+				URI: "file://" + filepath.Join(testdataRoot, "dbt_packages/jaffle_package/macros/not_empty.sql"),
+				Range: lsp.Range{
+					Start: lsp.Position{Line: 0, Character: 8},
+					End:   lsp.Position{Line: 0, Character: 37},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if actual := state.Definition(1, uri, tt.position).Result; actual != tt.expected {
+				t.Errorf("expected %v, got %v", tt.expected, actual)
+			}
+		})
+	}
+
+	// builtin tests ship with dbt, so there's nothing to jump to
+	position := lsp.Position{Line: 11, Character: 14}
+	if actual := state.Definition(2, uri, position).Result; actual.URI != uri {
+		t.Errorf("expected no definition for a builtin test, got %v", actual)
+	}
+}
@@ -25,6 +25,8 @@ type CTE struct {
 type TokenLL struct {
 	Token     Token
 	PrevToken *TokenLL
+	// NextToken is linked once parsing finishes, by CreateTokenIndex.
+	NextToken *TokenLL
 }
 
 func NewParser(input string, dialect docs.Dialect) *Parser {
@@ -76,7 +78,81 @@ func (p *Parser) parseWith() {
 	}
 }
 
+// parseRef marks the model name in ref('model') as REF. In the
+// ref('package', 'model') form the first argument becomes PACKAGE, and the
+// value of a v= or version= argument becomes VERSION.
 func (p *Parser) parseRef() {
+	p.NextToken()
+	if p.curTok.Type != LPAREN {
+		return
+	}
+	p.incParenCount()
+	p.NextToken()
+	if !isQuote(p.curTok) {
+		return
+	}
+	p.NextToken()
+	if p.curTok.Type != IDENT {
+		return
+	}
+	p.curTok.Type = REF
+
+	// the REF token is stored at this index once the parser moves past it
+	first := len(p.tokens)
+	if !p.nextRefArgument() {
+		return
+	}
+	if isQuote(p.peekTok) {
+		p.NextToken()
+		if p.peekTok.Type != IDENT {
+			return
+		}
+		p.tokens[first].Token.Type = PACKAGE
+		p.NextToken()
+		p.curTok.Type = REF
+		if !p.nextRefArgument() {
+			return
+		}
+	}
+	p.parseRefVersion()
+}
+
+// nextRefArgument moves from a quoted ref argument to the comma after it.
+func (p *Parser) nextRefArgument() bool {
+	if !isQuote(p.peekTok) {
+		return false
+	}
+	p.NextToken()
+	if p.peekTok.Type != COMMA {
+		return false
+	}
+	p.NextToken()
+	return true
+}
+
+func (p *Parser) parseRefVersion() {
+	if p.peekTok.Type != IDENT || (p.peekTok.Literal != "v" && p.peekTok.Literal != "version") {
+		return
+	}
+	p.NextToken()
+	if p.peekTok.Type != EQUAL {
+		return
+	}
+	p.NextToken()
+	if isQuote(p.peekTok) {
+		p.NextToken()
+	}
+	if p.peekTok.Type == INT || p.peekTok.Type == IDENT {
+		p.NextToken()
+		p.curTok.Type = VERSION
+	}
+}
+
+func isQuote(tok Token) bool {
+	return tok.Type == SINGLE_QUOTE || tok.Type == DOUBLE_QUOTE
+}
+
+func (p *Parser) parseVar() {
 	p.NextToken()
 	if p.curTok.Type == LPAREN {
 		p.incParenCount()
@@ -84,13 +160,15 @@ func (p *Parser) parseRef() {
 		if p.curTok.Type == SINGLE_QUOTE || p.curTok.Type == DOUBLE_QUOTE {
 			p.NextToken()
 			if p.curTok.Type == IDENT {
-				p.curTok.Type = REF
+				p.curTok.Type = VAR
 			}
 		}
 	}
 }
 
-func (p *Parser) parseVar() {
+// parseMetric marks the name in metric('name'). metric isn't a keyword, so
+// columns named metric still lex as identifiers.
+func (p *Parser) parseMetric() {
 	p.NextToken()
 	if p.curTok.Type == LPAREN {
 		p.incParenCount()
@@ -98,12 +176,16 @@ func (p *Parser) parseVar() {
 		if p.curTok.Type == SINGLE_QUOTE || p.curTok.Type == DOUBLE_QUOTE {
 			p.NextToken()
 			if p.curTok.Type == IDENT {
-				p.curTok.Type = VAR
+				p.curTok.Type = METRIC
 			}
 		}
 	}
 }
 
+func isMetricCall(tok Token, peek Token) bool {
+	return tok.Type == IDENT && tok.Literal == "metric" && peek.Type == LPAREN
+}
+
 func (p *Parser) parseMacro() {
 	if p.peekTok.Type == DOT {
 		p.curTok.Type = PACKAGE
@@ -189,6 +271,10 @@ func (p *Parser) parseTokens() {
 			p.parseRef()
 		case VAR:
 			p.parseVar()
+		case IDENT:
+			if isMetricCall(p.curTok, p.peekTok) {
+				p.parseMetric()
+			}
 		case DB_LBRACE:
 			switch p.peekTok.Type {
 			case CONFIG:
@@ -196,7 +282,11 @@ func (p *Parser) parseTokens() {
 				p.parseConfig()
 			case IDENT:
 				p.NextToken()
-				p.parseMacro()
+				if isMetricCall(p.curTok, p.peekTok) {
+					p.parseMetric()
+				} else {
+					p.parseMacro()
+				}
 			}
 		case JINJA_LBRACE:
 		case DB_RBRACE:
@@ -206,6 +296,12 @@ func (p *Parser) parseTokens() {
 	}
 }
 
+// CTETokens returns the name token of every CTE in the order they are
+// declared, including CTEs that share a name.
+func (p *Parser) CTETokens() []Token {
+	return p.ctes.Tokens
+}
+
 func (p *Parser) CreateTokenNameMap() map[string]Token {
 	tokenMap := make(map[string]Token)
 	for _, token := range p.ctes.Tokens {
@@ -215,11 +311,23 @@ func (p *Parser) CreateTokenNameMap() map[string]Token {
 }
 
 type TokenIndex struct {
+	tokens     []TokenLL
 	lineTokens map[int][]TokenLL
 }
 
 func (p *Parser) CreateTokenIndex() *TokenIndex {
+	// tokens move as the slice grows, so the links are set on the final slice
+	for i := range p.tokens {
+		if i > 0 {
+			p.tokens[i].PrevToken = &p.tokens[i-1]
+		}
+		if i+1 < len(p.tokens) {
+			p.tokens[i].NextToken = &p.tokens[i+1]
+		}
+	}
+
 	index := &TokenIndex{
+		tokens:     p.tokens,
 		lineTokens: make(map[int][]TokenLL),
 	}
 
@@ -230,7 +338,20 @@ func (p *Parser) CreateTokenIndex() *TokenIndex {
 	return index
 }
 
+// Tokens returns every parsed token in document order
+func (ti *TokenIndex) Tokens() []TokenLL {
+	if ti == nil {
+		return nil
+	}
+	return ti.tokens
+}
+
+// FindTokenAtCursor returns the token under the cursor. A nil index, e.g. of
+// a document that isn't open, has no tokens.
 func (ti *TokenIndex) FindTokenAtCursor(line, column int) (*TokenLL, error) {
+	if ti == nil {
+		return nil, errors.New("document is not indexed")
+	}
 	lineTokens, exists := ti.lineTokens[line]
 	if !exists {
 		return nil, errors.New("line does not exist")
@@ -241,7 +362,7 @@ func (ti *TokenIndex) FindTokenAtCursor(line, column int) (*TokenLL, error) {
 		return lineTokens[i].Token.Column+len(lineTokens[i].Token.Literal) > column
 	})
 
-	if idx >= 0 &&
+	if idx < len(lineTokens) &&
 		column >= lineTokens[idx].Token.Column &&
 		column < lineTokens[idx].Token.Column+len(lineTokens[idx].Token.Literal) {
 		return &lineTokens[idx], nil
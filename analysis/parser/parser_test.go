@@ -245,3 +245,128 @@ select * from {{ ref('users') }}`
 		}
 	}
 }
+
+func TestParseMetric(t *testing.T) {
+	input := `select {{ metric('revenue') }}, metric
+depends_on: [metric("orders")]`
+
+	expected := []Token{
+		{Type: SELECT, Literal: "select", Line: 0, Column: 0},
+		{Type: DB_LBRACE, Literal: "{{", Line: 0, Column: 7},
+		{Type: IDENT, Literal: "metric", Line: 0, Column: 10},
+		{Type: LPAREN, Literal: "(", Line: 0, Column: 16},
+		{Type: SINGLE_QUOTE, Literal: "'", Line: 0, Column: 17},
+		{Type: METRIC, Literal: "revenue", Line: 0, Column: 18},
+		{Type: SINGLE_QUOTE, Literal: "'", Line: 0, Column: 25},
+		{Type: RPAREN, Literal: ")", Line: 0, Column: 26},
+		{Type: DB_RBRACE, Literal: "}}", Line: 0, Column: 28},
+		{Type: COMMA, Literal: ",", Line: 0, Column: 30},
+		{Type: IDENT, Literal: "metric", Line: 0, Column: 32},
+
+		{Type: IDENT, Literal: "depends_on", Line: 1, Column: 0},
+		{Type: ILLEGAL, Literal: ":", Line: 1, Column: 10},
+		{Type: ILLEGAL, Literal: "[", Line: 1, Column: 12},
+		{Type: IDENT, Literal: "metric", Line: 1, Column: 13},
+		{Type: LPAREN, Literal: "(", Line: 1, Column: 19},
+		{Type: DOUBLE_QUOTE, Literal: "\"", Line: 1, Column: 20},
+		{Type: METRIC, Literal: "orders", Line: 1, Column: 21},
+	}
+
+	p := Parse(input, docs.Dialect("snowflake"))
+	tokens := p.tokens
+
+	for i, expToken := range expected {
+		if i >= len(tokens) {
+			t.Fatalf("tokens[%d] - expected=%v, got=<missing>", i, expToken)
+		}
+		if expToken != tokens[i].Token {
+			t.Fatalf("tokens[%d] - expected=%v, got=%v", i, expToken, tokens[i].Token)
+		}
+	}
+}
+
+func TestParseRefArguments(t *testing.T) {
+	input := `{{ ref('jaffle_package', 'orders', v=2) }}
+{{ ref("dim_customers", version='1') }}`
+
+	expected := []Token{
+		{Type: DB_LBRACE, Literal: "{{", Line: 0, Column: 0},
+		{Type: REF, Literal: "ref", Line: 0, Column: 3},
+		{Type: LPAREN, Literal: "(", Line: 0, Column: 6},
+		{Type: SINGLE_QUOTE, Literal: "'", Line: 0, Column: 7},
+		{Type: PACKAGE, Literal: "jaffle_package", Line: 0, Column: 8},
+		{Type: SINGLE_QUOTE, Literal: "'", Line: 0, Column: 22},
+		{Type: COMMA, Literal: ",", Line: 0, Column: 23},
+		{Type: SINGLE_QUOTE, Literal: "'", Line: 0, Column: 25},
+		{Type: REF, Literal: "orders", Line: 0, Column: 26},
+		{Type: SINGLE_QUOTE, Literal: "'", Line: 0, Column: 32},
+		{Type: COMMA, Literal: ",", Line: 0, Column: 33},
+		{Type: IDENT, Literal: "v", Line: 0, Column: 35},
+		{Type: EQUAL, Literal: "=", Line: 0, Column: 36},
+		{Type: VERSION, Literal: "2", Line: 0, Column: 37},
+		{Type: RPAREN, Literal: ")", Line: 0, Column: 38},
+		{Type: DB_RBRACE, Literal: "}}", Line: 0, Column: 40},
+
+		{Type: DB_LBRACE, Literal: "{{", Line: 1, Column: 0},
+		{Type: REF, Literal: "ref", Line: 1, Column: 3},
+		{Type: LPAREN, Literal: "(", Line: 1, Column: 6},
+		{Type: DOUBLE_QUOTE, Literal: "\"", Line: 1, Column: 7},
+		{Type: REF, Literal: "dim_customers", Line: 1, Column: 8},
+		{Type: DOUBLE_QUOTE, Literal: "\"", Line: 1, Column: 21},
+		{Type: COMMA, Literal: ",", Line: 1, Column: 22},
+		{Type: IDENT, Literal: "version", Line: 1, Column: 24},
+		{Type: EQUAL, Literal: "=", Line: 1, Column: 31},
+		{Type: SINGLE_QUOTE, Literal: "'", Line: 1, Column: 32},
+		{Type: VERSION, Literal: "1", Line: 1, Column: 33},
+		{Type: SINGLE_QUOTE, Literal: "'", Line: 1, Column: 34},
+		{Type: RPAREN, Literal: ")", Line: 1, Column: 35},
+	}
+
+	tokens := Parse(input, docs.Dialect("snowflake")).CreateTokenIndex().Tokens()
+
+	for i, expToken := range expected {
+		if i >= len(tokens) {
+			t.Fatalf("tokens[%d] - expected=%v, got=<missing>", i, expToken)
+		}
+		if expToken != tokens[i].Token {
+			t.Fatalf("tokens[%d] - expected=%v, got=%v", i, expToken, tokens[i].Token)
+		}
+	}
+
+	// the index links tokens both ways
+	if tokens[8].NextToken.Token.Type != SINGLE_QUOTE || tokens[8].PrevToken.Token.Type != SINGLE_QUOTE {
+		t.Errorf("expected the model name to be linked to its quotes, got %v", tokens[8])
+	}
+}
+
+func TestFindTokenAtCursor(t *testing.T) {
+	index := Parse("select id\nfrom {{ ref('orders') }}", docs.Dialect("snowflake")).CreateTokenIndex()
+
+	tests := []struct {
+		line, column int
+		expected     string
+	}{
+		{0, 7, "id"},
+		{1, 14, "orders"},
+		{0, 40, ""}, // past the last token of the line
+		{1, -1, ""},
+		{5, 0, ""},
+	}
+	for _, tt := range tests {
+		token, err := index.FindTokenAtCursor(tt.line, tt.column)
+		if tt.expected == "" {
+			if err == nil {
+				t.Errorf("%d:%d: expected no token, got %v", tt.line, tt.column, token.Token)
+			}
+			continue
+		}
+		if err != nil || token.Token.Literal != tt.expected {
+			t.Errorf("%d:%d: expected %q, got %v (%v)", tt.line, tt.column, tt.expected, token, err)
+		}
+	}
+
+	var unindexed *TokenIndex
+	if _, err := unindexed.FindTokenAtCursor(0, 0); err == nil {
+		t.Error("expected an error from a nil index")
+	}
+}
@@ -0,0 +1,811 @@
+package parser
+
+import "strings"
+
+// The query parser builds a loose SELECT syntax tree from the token stream.
+// It is permissive by design: unknown syntax is skipped rather than
+// rejected so half-typed models still produce a useful tree.
+
+type sqlTokenKind int
+
+const (
+	sqlWord sqlTokenKind = iota
+	sqlString
+	sqlNumber
+	sqlPunct
+	sqlJinja
+)
+
+// sqlToken is a token of the SQL text with string literals, quoted
+// identifiers and {{ ... }} expressions folded into single tokens.
+type sqlToken struct {
+	kind  sqlTokenKind
+	text  string
+	start Token
+	end   Token
+	inner []TokenLL
+}
+
+func (t sqlToken) is(keyword string) bool {
+	return t.kind == sqlWord && strings.EqualFold(t.text, keyword)
+}
+
+func (t sqlToken) isPunct(punct string) bool {
+	return t.kind == sqlPunct && t.text == punct
+}
+
+// nameToken returns a token whose literal is the folded text, for names
+// built from quoted identifiers or jinja.
+func (t sqlToken) nameToken() Token {
+	if t.start.Literal == t.text {
+		return t.start
+	}
+	return Token{Type: IDENT, Literal: t.text, Line: t.start.Line, Column: t.start.Column}
+}
+
+func adjacent(a Token, b Token) bool {
+	return a.Line == b.Line && a.Column+len(a.Literal) == b.Column
+}
+
+// foldTokens drops comments and jinja statements and folds multi-token
+// constructs so the parser can work on whole words and literals.
+func foldTokens(tokens []TokenLL) []sqlToken {
+	folded := []sqlToken{}
+
+	for i := 0; i < len(tokens); i++ {
+		token := tokens[i].Token
+		next := Token{}
+		if i+1 < len(tokens) {
+			next = tokens[i+1].Token
+		}
+
+		switch {
+		case token.Type == MINUS && next.Type == MINUS && adjacent(token, next):
+			for i+1 < len(tokens) && tokens[i+1].Token.Line == token.Line {
+				i++
+			}
+		case token.Type == SLASH && next.Type == ASTERISK && adjacent(token, next):
+			i += 2
+			for i+1 < len(tokens) && !(tokens[i].Token.Type == ASTERISK && tokens[i+1].Token.Type == SLASH && adjacent(tokens[i].Token, tokens[i+1].Token)) {
+				i++
+			}
+			i++
+		case token.Type == LBRACE && next.Literal == "#" && adjacent(token, next):
+			i += 2
+			for i+1 < len(tokens) && !(tokens[i].Token.Literal == "#" && tokens[i+1].Token.Type == RBRACE) {
+				i++
+			}
+			i++
+		case token.Type == JINJA_LBRACE:
+			for i < len(tokens) && tokens[i].Token.Type != JINJA_RBRACE {
+				i++
+			}
+		case token.Type == DB_LBRACE:
+			start := i
+			for i < len(tokens) && tokens[i].Token.Type != DB_RBRACE {
+				i++
+			}
+			end := i
+			if end >= len(tokens) {
+				end = len(tokens) - 1
+			}
+			// an unterminated {{ at the end of the text folds to an empty expression
+			literals := []string{}
+			if end > start {
+				for _, t := range tokens[start+1 : end] {
+					literals = append(literals, t.Token.Literal)
+				}
+			}
+			folded = appendJoined(folded, sqlToken{
+				kind:  sqlJinja,
+				text:  "{{" + strings.Join(literals, "") + "}}",
+				start: tokens[start].Token,
+				end:   tokens[end].Token,
+				inner: tokens[start:end],
+			})
+		case token.Type == SINGLE_QUOTE || token.Type == DOUBLE_QUOTE || token.Type == BACKTICK:
+			start := i
+			parts := []string{}
+			i++
+			for i < len(tokens) && tokens[i].Token.Type != token.Type {
+				parts = append(parts, tokens[i].Token.Literal)
+				i++
+			}
+			end := i
+			if end >= len(tokens) {
+				end = len(tokens) - 1
+			}
+			kind := sqlWord
+			if token.Type == SINGLE_QUOTE {
+				kind = sqlString
+			}
+			folded = append(folded, sqlToken{
+				kind:  kind,
+				text:  strings.Join(parts, " "),
+				start: tokens[start].Token,
+				end:   tokens[end].Token,
+			})
+		case token.Type == INT:
+			folded = append(folded, sqlToken{kind: sqlNumber, text: token.Literal, start: token, end: token})
+		case isWordToken(token):
+			folded = appendJoined(folded, sqlToken{kind: sqlWord, text: token.Literal, start: token, end: token})
+		default:
+			folded = append(folded, sqlToken{kind: sqlPunct, text: token.Literal, start: token, end: token})
+		}
+	}
+
+	return folded
+}
+
+// appendJoined glues a word or jinja expression onto the previous one when
+// nothing separates them, so `{{ method }}_amount` is a single name.
+func appendJoined(folded []sqlToken, t sqlToken) []sqlToken {
+	if len(folded) > 0 {
+		prev := folded[len(folded)-1]
+		joinable := (prev.kind == sqlWord || prev.kind == sqlJinja) && (prev.kind == sqlJinja || t.kind == sqlJinja)
+		if joinable && adjacent(prev.end, t.start) {
+			folded[len(folded)-1] = sqlToken{
+				kind:  sqlWord,
+				text:  prev.text + t.text,
+				start: prev.start,
+				end:   t.end,
+				inner: append(append([]TokenLL{}, prev.inner...), t.inner...),
+			}
+			return folded
+		}
+	}
+	return append(folded, t)
+}
+
+func isWordToken(token Token) bool {
+	if token.Literal == "" {
+		return false
+	}
+	c := token.Literal[0]
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+// Query is a SELECT statement with its CTEs. Set operations produce one
+// Select per branch.
+type Query struct {
+	CTEs    []*QueryCTE
+	Selects []*Select
+}
+
+type QueryCTE struct {
+	Name  Token
+	Query *Query
+}
+
+type Select struct {
+	Items []*SelectItem
+	From  []*TableRef
+}
+
+type SelectItem struct {
+	// Start and End span the whole item, alias included.
+	Start Token
+	End   Token
+	// ExprEnd is the last token of the expression, before any alias.
+	ExprEnd Token
+	Alias   *Token
+	// Star is set for `*`, `alias.*` and `{{ dbt_utils.star() }}`.
+	// StarQualifier holds the alias and StarExcept the columns removed by
+	// EXCLUDE/EXCEPT. StarRelation, StarPrefix and StarSuffix come from the
+	// dbt_utils.star arguments.
+	Star          bool
+	StarQualifier string
+	StarExcept    []string
+	StarRelation  *TableRef
+	StarPrefix    string
+	StarSuffix    string
+	Columns       []ColumnRef
+	// Jinja holds {{ ... }} expressions in the item, e.g. dbt_utils.star().
+	Jinja [][]TokenLL
+
+	bareColumn bool
+}
+
+// ColumnRef is a column read by an expression. Qualifier is the table alias
+// in `o.order_id` and empty for bare names.
+type ColumnRef struct {
+	Qualifier string
+	Name      Token
+}
+
+type TableRefKind int
+
+const (
+	TableName TableRefKind = iota
+	TableModel
+	TableSource
+	TableSubquery
+)
+
+// TableRef is a table a select reads from. Package and Version are the
+// package and v= arguments of a ref, Source the source of a source table.
+type TableRef struct {
+	Kind     TableRefKind
+	Name     string
+	Source   string
+	Package  string
+	Version  string
+	Alias    string
+	Token    Token
+	Subquery *Query
+}
+
+// Qualifier is the name columns use to refer to this table.
+func (t *TableRef) Qualifier() string {
+	if t.Alias != "" {
+		return t.Alias
+	}
+	return t.Name
+}
+
+// OutputName is the column name an item produces, if it has one.
+func (item *SelectItem) OutputName() (Token, bool) {
+	if item.Alias != nil {
+		return *item.Alias, true
+	}
+	if item.bareColumn {
+		return item.Columns[0].Name, true
+	}
+	return Token{}, false
+}
+
+type queryParser struct {
+	tokens []sqlToken
+	pos    int
+}
+
+// ParseQuery parses the SELECT statement in tokens. It returns nil when no
+// SELECT is found.
+func ParseQuery(tokens []TokenLL) *Query {
+	p := &queryParser{tokens: foldTokens(tokens)}
+	for p.pos < len(p.tokens) && !p.cur().is("with") && !p.cur().is("select") && !p.cur().isPunct("(") {
+		p.pos++
+	}
+	if p.pos >= len(p.tokens) {
+		return nil
+	}
+	query := p.parseQuery()
+	if len(query.Selects) == 0 {
+		return nil
+	}
+	return query
+}
+
+func (p *queryParser) cur() sqlToken {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+	return sqlToken{kind: sqlPunct}
+}
+
+func (p *queryParser) peek(n int) sqlToken {
+	if p.pos+n < len(p.tokens) {
+		return p.tokens[p.pos+n]
+	}
+	return sqlToken{kind: sqlPunct}
+}
+
+func (p *queryParser) done() bool {
+	return p.pos >= len(p.tokens)
+}
+
+// skipParens moves past the parenthesised group starting at the current
+// token.
+func (p *queryParser) skipParens() {
+	depth := 0
+	for !p.done() {
+		if p.cur().isPunct("(") {
+			depth++
+		} else if p.cur().isPunct(")") {
+			depth--
+			if depth == 0 {
+				p.pos++
+				return
+			}
+		}
+		p.pos++
+	}
+}
+
+func (p *queryParser) parseQuery() *Query {
+	query := &Query{}
+
+	if p.cur().is("with") {
+		p.pos++
+		if p.cur().is("recursive") {
+			p.pos++
+		}
+		for !p.done() && p.cur().kind == sqlWord {
+			cte := &QueryCTE{Name: p.cur().start}
+			p.pos++
+			if p.cur().isPunct("(") {
+				p.skipParens()
+			}
+			if p.cur().is("as") {
+				p.pos++
+			}
+			if p.cur().is("materialized") || p.cur().is("not") {
+				for !p.done() && !p.cur().isPunct("(") {
+					p.pos++
+				}
+			}
+			if !p.cur().isPunct("(") {
+				break
+			}
+			p.pos++
+			cte.Query = p.parseQuery()
+			if p.cur().isPunct(")") {
+				p.pos++
+			}
+			query.CTEs = append(query.CTEs, cte)
+			if !p.cur().isPunct(",") {
+				break
+			}
+			p.pos++
+		}
+	}
+
+	for !p.done() {
+		if p.cur().isPunct("(") {
+			p.pos++
+			inner := p.parseQuery()
+			query.Selects = append(query.Selects, inner.Selects...)
+			if p.cur().isPunct(")") {
+				p.pos++
+			}
+		} else if p.cur().is("select") {
+			query.Selects = append(query.Selects, p.parseSelect())
+		} else {
+			break
+		}
+
+		p.skipClauses()
+		if p.cur().is("union") || p.cur().is("except") || p.cur().is("intersect") || p.cur().is("minus") {
+			p.pos++
+			if p.cur().is("all") || p.cur().is("distinct") {
+				p.pos++
+			}
+			if p.cur().is("by") && p.peek(1).is("name") {
+				p.pos += 2
+			}
+			continue
+		}
+		break
+	}
+
+	return query
+}
+
+// skipClauses moves past WHERE, GROUP BY, ORDER BY and other trailing
+// clauses up to a set operation or the end of the enclosing query.
+func (p *queryParser) skipClauses() {
+	for !p.done() {
+		switch {
+		case p.cur().isPunct("("):
+			p.skipParens()
+		case p.cur().isPunct(")"), p.cur().isPunct(";"):
+			return
+		case p.cur().is("union"), p.cur().is("except"), p.cur().is("intersect"), p.cur().is("minus"):
+			return
+		default:
+			p.pos++
+		}
+	}
+}
+
+var selectItemTerminators = []string{"from", "where", "group", "having", "qualify", "window", "order", "limit", "union", "except", "intersect", "minus", "into"}
+
+func (p *queryParser) atItemEnd(depth int) bool {
+	if p.done() {
+		return true
+	}
+	t := p.cur()
+	if depth > 0 {
+		return false
+	}
+	if t.isPunct(",") || t.isPunct(")") || t.isPunct(";") {
+		return true
+	}
+	for _, keyword := range selectItemTerminators {
+		if t.is(keyword) {
+			// EXCEPT directly after a star is BigQuery's column exclusion
+			if keyword == "except" && p.pos > 0 && p.tokens[p.pos-1].isPunct("*") {
+				return false
+			}
+			return true
+		}
+	}
+	return false
+}
+
+func (p *queryParser) parseSelect() *Select {
+	sel := &Select{}
+	p.pos++ // select
+
+	if p.cur().is("distinct") || p.cur().is("all") {
+		p.pos++
+		if p.cur().is("on") && p.peek(1).isPunct("(") {
+			p.pos++
+			p.skipParens()
+		}
+	}
+	if p.cur().is("top") && p.peek(1).kind == sqlNumber {
+		p.pos += 2
+	}
+
+	for !p.done() {
+		if item := p.parseSelectItem(); item != nil {
+			sel.Items = append(sel.Items, item)
+		}
+		if !p.cur().isPunct(",") {
+			break
+		}
+		p.pos++
+	}
+
+	if p.cur().is("from") {
+		p.pos++
+		sel.From = p.parseFrom()
+	}
+
+	return sel
+}
+
+func (p *queryParser) parseSelectItem() *SelectItem {
+	startPos := p.pos
+	depth := 0
+	for !p.atItemEnd(depth) {
+		if p.cur().isPunct("(") {
+			depth++
+		} else if p.cur().isPunct(")") {
+			depth--
+		}
+		p.pos++
+	}
+	if p.pos == startPos {
+		return nil
+	}
+	return newSelectItem(p.tokens[startPos:p.pos])
+}
+
+// expressionKeywords are words inside expressions that are not columns.
+var expressionKeywords = map[string]bool{
+	"case": true, "when": true, "then": true, "else": true, "end": true,
+	"and": true, "or": true, "not": true, "is": true, "null": true, "in": true,
+	"like": true, "ilike": true, "rlike": true, "between": true, "distinct": true,
+	"over": true, "partition": true, "by": true, "order": true, "asc": true,
+	"desc": true, "rows": true, "range": true, "interval": true, "true": true,
+	"false": true, "filter": true, "within": true, "group": true, "current": true,
+	"row": true, "preceding": true, "following": true, "unbounded": true,
+	"nulls": true, "first": true, "last": true, "exists": true, "any": true,
+	"all": true, "some": true, "as": true, "escape": true, "similar": true,
+	"to": true, "at": true, "time": true, "zone": true, "ignore": true,
+	"respect": true, "date": true, "timestamp": true, "lateral": true,
+	"current_date": true, "current_timestamp": true, "current_time": true,
+	"localtime": true, "localtimestamp": true, "current_user": true,
+}
+
+func newSelectItem(tokens []sqlToken) *SelectItem {
+	item := &SelectItem{
+		Start:   tokens[0].start,
+		End:     tokens[len(tokens)-1].end,
+		ExprEnd: tokens[len(tokens)-1].end,
+	}
+
+	exprTokens := tokens
+	n := len(tokens)
+	if n >= 3 && tokens[n-2].is("as") && tokens[n-1].kind == sqlWord {
+		alias := tokens[n-1].nameToken()
+		item.Alias = &alias
+		exprTokens = tokens[:n-2]
+	} else if n >= 2 && tokens[n-1].kind == sqlWord && !expressionKeywords[strings.ToLower(tokens[n-1].text)] {
+		prev := tokens[n-2]
+		if prev.kind != sqlPunct || prev.isPunct(")") {
+			if !(prev.kind == sqlWord && expressionKeywords[strings.ToLower(prev.text)]) {
+				alias := tokens[n-1].nameToken()
+				item.Alias = &alias
+				exprTokens = tokens[:n-1]
+			}
+		}
+	}
+	item.ExprEnd = exprTokens[len(exprTokens)-1].end
+
+	if len(exprTokens) == 1 && exprTokens[0].kind == sqlJinja {
+		if starMacro(item, exprTokens[0].inner) {
+			return item
+		}
+	}
+
+	// `*`, `t.*` and their EXCLUDE / EXCEPT lists
+	starAt := -1
+	if exprTokens[0].isPunct("*") {
+		starAt = 0
+	} else if len(exprTokens) >= 3 && exprTokens[1].isPunct(".") && exprTokens[2].isPunct("*") {
+		starAt = 2
+		item.StarQualifier = exprTokens[0].text
+	}
+	if starAt >= 0 {
+		item.Star = true
+		item.Alias = nil
+		rest := exprTokens[starAt+1:]
+		if len(rest) > 0 && (rest[0].is("exclude") || rest[0].is("except")) {
+			for _, t := range rest[1:] {
+				if t.kind == sqlWord {
+					item.StarExcept = append(item.StarExcept, t.text)
+				}
+			}
+		}
+		return item
+	}
+
+	for i := 0; i < len(exprTokens); i++ {
+		t := exprTokens[i]
+		if t.kind == sqlJinja {
+			item.Jinja = append(item.Jinja, t.inner)
+			continue
+		}
+		if t.kind != sqlWord {
+			continue
+		}
+
+		// collect a dotted name such as schema.table.column
+		parts := []sqlToken{t}
+		for i+2 < len(exprTokens) && exprTokens[i+1].isPunct(".") && exprTokens[i+2].kind == sqlWord {
+			parts = append(parts, exprTokens[i+2])
+			i += 2
+		}
+
+		if i+1 < len(exprTokens) && exprTokens[i+1].isPunct("(") {
+			continue // function call
+		}
+		first := i - 2*(len(parts)-1)
+		if first > 0 && (exprTokens[first-1].is("as") || isCastOperator(exprTokens, first)) {
+			continue // type name in a cast
+		}
+		if len(parts) == 1 && expressionKeywords[strings.ToLower(t.text)] {
+			continue
+		}
+
+		ref := ColumnRef{Name: parts[len(parts)-1].nameToken()}
+		if len(parts) > 1 {
+			ref.Qualifier = parts[len(parts)-2].text
+		}
+		item.Columns = append(item.Columns, ref)
+	}
+	item.bareColumn = len(item.Columns) == 1 && isDottedName(exprTokens)
+
+	return item
+}
+
+func isDottedName(tokens []sqlToken) bool {
+	if len(tokens)%2 == 0 {
+		return false
+	}
+	for i, t := range tokens {
+		if i%2 == 0 && t.kind != sqlWord {
+			return false
+		}
+		if i%2 == 1 && !t.isPunct(".") {
+			return false
+		}
+	}
+	return true
+}
+
+// isCastOperator reports whether the tokens before index i are `::`.
+func isCastOperator(tokens []sqlToken, i int) bool {
+	return i >= 2 && tokens[i-1].text == ":" && tokens[i-2].text == ":"
+}
+
+var joinKeywords = map[string]bool{
+	"join": true, "inner": true, "left": true, "right": true, "full": true,
+	"outer": true, "cross": true, "natural": true, "lateral": true,
+	"any": true, "asof": true, "semi": true, "anti": true, "positional": true,
+}
+
+var fromTerminators = map[string]bool{
+	"where": true, "group": true, "having": true, "qualify": true,
+	"window": true, "order": true, "limit": true, "union": true,
+	"except": true, "intersect": true, "minus": true, "on": true,
+	"using": true, "pivot": true, "unpivot": true, "sample": true,
+	"tablesample": true, "offset": true, "fetch": true,
+}
+
+func (p *queryParser) parseFrom() []*TableRef {
+	tables := []*TableRef{}
+
+	for !p.done() {
+		if table := p.parseTableFactor(); table != nil {
+			tables = append(tables, table)
+		}
+
+		// skip join conditions up to the next table or the end of FROM
+		for !p.done() {
+			t := p.cur()
+			if t.isPunct(",") {
+				p.pos++
+				break
+			}
+			if t.kind == sqlWord && joinKeywords[strings.ToLower(t.text)] {
+				for !p.done() && p.cur().kind == sqlWord && joinKeywords[strings.ToLower(p.cur().text)] {
+					p.pos++
+				}
+				break
+			}
+			if t.is("on") || t.is("using") {
+				p.pos++
+				depth := 0
+				for !p.done() {
+					c := p.cur()
+					if c.isPunct("(") {
+						depth++
+					} else if c.isPunct(")") {
+						if depth == 0 {
+							break
+						}
+						depth--
+					} else if depth == 0 && (c.isPunct(",") || (c.kind == sqlWord && (joinKeywords[strings.ToLower(c.text)] || (fromTerminators[strings.ToLower(c.text)] && !c.is("on") && !c.is("using"))))) {
+						break
+					}
+					p.pos++
+				}
+				continue
+			}
+			return tables
+		}
+	}
+
+	return tables
+}
+
+func (p *queryParser) parseTableFactor() *TableRef {
+	t := p.cur()
+	table := &TableRef{Token: t.start}
+
+	switch {
+	case t.isPunct("("):
+		p.pos++
+		if p.cur().is("select") || p.cur().is("with") || p.cur().isPunct("(") {
+			table.Kind = TableSubquery
+			table.Subquery = p.parseQuery()
+			if p.cur().isPunct(")") {
+				p.pos++
+			}
+		} else {
+			p.pos--
+			p.skipParens()
+			return nil
+		}
+	case t.kind == sqlJinja:
+		p.pos++
+		if relation, ok := jinjaRelation(t.inner); ok {
+			relation.Token = table.Token
+			table = &relation
+		} else {
+			table.Kind = TableName
+			table.Name = ""
+		}
+	case t.kind == sqlWord && !fromTerminators[strings.ToLower(t.text)]:
+		// a possibly qualified table name or a table function
+		name := t
+		p.pos++
+		for p.cur().isPunct(".") && p.peek(1).kind == sqlWord {
+			name = p.peek(1)
+			p.pos += 2
+		}
+		if p.cur().isPunct("(") {
+			p.skipParens()
+		}
+		table.Kind = TableName
+		table.Name = name.text
+		table.Token = name.start
+	default:
+		return nil
+	}
+
+	if p.cur().is("as") {
+		p.pos++
+	}
+	if c := p.cur(); c.kind == sqlWord && !fromTerminators[strings.ToLower(c.text)] && !joinKeywords[strings.ToLower(c.text)] {
+		table.Alias = c.text
+		p.pos++
+		if p.cur().isPunct("(") {
+			p.skipParens() // column alias list
+		}
+	}
+
+	return table
+}
+
+// starMacro reads `dbt_utils.star(from=ref('m'), except=['a'], prefix='p_')`
+// into item. Only the relation, except, prefix and suffix arguments change
+// the columns produced.
+func starMacro(item *SelectItem, inner []TokenLL) bool {
+	open := -1
+	for i := 0; i+3 < len(inner); i++ {
+		if inner[i].Token.Literal == "dbt_utils" && inner[i+1].Token.Type == DOT &&
+			inner[i+2].Token.Literal == "star" && inner[i+3].Token.Type == LPAREN {
+			open = i + 3
+			break
+		}
+	}
+	if open == -1 {
+		return false
+	}
+
+	relation, ok := jinjaRelation(inner[open:])
+	if !ok {
+		return false
+	}
+	item.Star = true
+	item.StarRelation = &relation
+
+	// keyword arguments hold quoted strings, or lists of them for except
+	argument := ""
+	for i := open + 1; i < len(inner); i++ {
+		token := inner[i].Token
+		if isWordToken(token) && i+1 < len(inner) && inner[i+1].Token.Type == EQUAL {
+			argument = token.Literal
+			continue
+		}
+		isQuote := token.Type == SINGLE_QUOTE || token.Type == DOUBLE_QUOTE
+		if !isQuote || i+2 >= len(inner) || inner[i+2].Token.Type != token.Type {
+			continue
+		}
+		i++
+		token = inner[i].Token
+		i++
+		switch argument {
+		case "except":
+			item.StarExcept = append(item.StarExcept, token.Literal)
+		case "prefix":
+			item.StarPrefix = token.Literal
+		case "suffix":
+			item.StarSuffix = token.Literal
+		}
+	}
+	return true
+}
+
+// jinjaRelation reads ref('model'), ref('package', 'model', v=1) and
+// source('source', 'table') calls.
+func jinjaRelation(inner []TokenLL) (TableRef, bool) {
+	for i := range inner {
+		token := inner[i].Token
+		quoted := inner[i].PrevToken != nil && (inner[i].PrevToken.Token.Type == SINGLE_QUOTE || inner[i].PrevToken.Token.Type == DOUBLE_QUOTE)
+		if !quoted {
+			continue
+		}
+		switch token.Type {
+		case REF:
+			_, packageName := inner[i].TokenLookbackMatch(PACKAGE, 4)
+			return TableRef{Kind: TableModel, Package: packageName, Name: token.Literal, Version: refVersion(inner[i+1:])}, true
+		case SOURCE_TABLE:
+			for k := i - 1; k >= 0; k-- {
+				if inner[k].Token.Type == SOURCE {
+					return TableRef{Kind: TableSource, Source: inner[k].Token.Literal, Name: token.Literal}, true
+				}
+			}
+		}
+	}
+	return TableRef{}, false
+}
+
+// refVersion returns the v= or version= argument in the tokens following
+// the model name of a ref.
+func refVersion(rest []TokenLL) string {
+	for _, t := range rest {
+		switch t.Token.Type {
+		case VERSION:
+			return t.Token.Literal
+		case RPAREN:
+			return ""
+		}
+	}
+	return ""
+}
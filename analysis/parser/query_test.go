@@ -0,0 +1,237 @@
+package parser
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// describeSelect summarizes a select as `output <- qualifier.column` items
+// and `kind:name alias` tables so expectations stay readable.
+func describeSelect(sel *Select) ([]string, []string) {
+	items := []string{}
+	for _, item := range sel.Items {
+		if item.Star {
+			desc := "*"
+			if item.StarQualifier != "" {
+				desc = item.StarQualifier + ".*"
+			}
+			if len(item.StarExcept) > 0 {
+				desc += " except " + strings.Join(item.StarExcept, ",")
+			}
+			items = append(items, desc)
+			continue
+		}
+		name := "?"
+		if token, ok := item.OutputName(); ok {
+			name = token.Literal
+		}
+		columns := []string{}
+		for _, c := range item.Columns {
+			if c.Qualifier != "" {
+				columns = append(columns, c.Qualifier+"."+c.Name.Literal)
+			} else {
+				columns = append(columns, c.Name.Literal)
+			}
+		}
+		items = append(items, name+" <- "+strings.Join(columns, ","))
+	}
+
+	tables := []string{}
+	for _, t := range sel.From {
+		kinds := map[TableRefKind]string{TableName: "name", TableModel: "ref", TableSource: "source", TableSubquery: "subquery"}
+		desc := kinds[t.Kind] + ":" + t.Name
+		if t.Source != "" {
+			desc = kinds[t.Kind] + ":" + t.Source + "." + t.Name
+		}
+		if t.Alias != "" {
+			desc += " " + t.Alias
+		}
+		tables = append(tables, desc)
+	}
+	return items, tables
+}
+
+func TestParseQuery(t *testing.T) {
+	input := `{{ config(materialized='table') }}
+{% set methods = ['a', 'b'] %}
+with orders as (
+    select * from {{ ref('stg_orders') }}
+),
+
+payments as (
+    -- amounts are in cents
+    select
+        order_id,
+        {% for m in methods %}
+        sum(case when method = '{{ m }}' then amount end) as {{ m }}_amount,
+        {% endfor %}
+        amount / 100 as amount_dollars,
+        cast(created_at as date) created_date,
+        payload::varchar raw_payload
+    from {{ source('stripe', 'payment') }} as p
+)
+
+select
+    o.order_id,
+    o.*,
+    p.amount_dollars as "Amount",
+    coalesce(p.raw_payload, 'none'),
+    count(*) over (partition by o.customer_id order by o.order_date) as order_seq
+from orders o
+left join payments p on o.order_id = p.order_id and p.amount_dollars > 0
+cross join (select 1 as one) extra
+where o.status != 'returned'
+union all
+select * exclude (customer_id) from analytics.public.legacy_orders`
+
+	query := ParseQuery(Parse(input, "").CreateTokenIndex().Tokens())
+	if query == nil {
+		t.Fatal("expected a query")
+	}
+
+	cteNames := []string{}
+	for _, cte := range query.CTEs {
+		cteNames = append(cteNames, cte.Name.Literal)
+	}
+	if !reflect.DeepEqual(cteNames, []string{"orders", "payments"}) {
+		t.Fatalf("unexpected ctes %v", cteNames)
+	}
+
+	tests := []struct {
+		name   string
+		sel    *Select
+		items  []string
+		tables []string
+	}{
+		{
+			name:   "orders cte",
+			sel:    query.CTEs[0].Query.Selects[0],
+			items:  []string{"*"},
+			tables: []string{"ref:stg_orders"},
+		},
+		{
+			name: "payments cte",
+			sel:  query.CTEs[1].Query.Selects[0],
+			items: []string{
+				"order_id <- order_id",
+				"{{m}}_amount <- method,amount",
+				"amount_dollars <- amount",
+				"created_date <- created_at",
+				"raw_payload <- payload",
+			},
+			tables: []string{"source:stripe.payment p"},
+		},
+		{
+			name: "final select",
+			sel:  query.Selects[0],
+			items: []string{
+				"order_id <- o.order_id",
+				"o.*",
+				"Amount <- p.amount_dollars",
+				"? <- p.raw_payload",
+				"order_seq <- o.customer_id,o.order_date",
+			},
+			tables: []string{"name:orders o", "name:payments p", "subquery: extra"},
+		},
+		{
+			name:   "union branch",
+			sel:    query.Selects[1],
+			items:  []string{"* except customer_id"},
+			tables: []string{"name:legacy_orders"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			items, tables := describeSelect(tt.sel)
+			if !reflect.DeepEqual(items, tt.items) {
+				t.Errorf("items: expected %q, got %q", tt.items, items)
+			}
+			if !reflect.DeepEqual(tables, tt.tables) {
+				t.Errorf("tables: expected %q, got %q", tt.tables, tables)
+			}
+		})
+	}
+
+	if len(query.Selects) != 2 {
+		t.Fatalf("expected two union branches, got %d", len(query.Selects))
+	}
+}
+
+func TestParseQueryNoSelect(t *testing.T) {
+	for _, input := range []string{"", "{% macro x() %}{% endmacro %}", "{{ config(materialized='view') }}"} {
+		if query := ParseQuery(Parse(input, "").CreateTokenIndex().Tokens()); query != nil {
+			t.Errorf("expected no query for %q, got %s", input, fmt.Sprint(query))
+		}
+	}
+}
+
+func TestParseQueryStarMacro(t *testing.T) {
+	input := `select
+    {{ dbt_utils.star(from=ref('stg_orders'), except=["status", 'amount'], prefix='o_') }},
+    {{ dbt_utils.star(source('raw', 'payments'), suffix='_p') }},
+    {{ dbt_utils.generate_surrogate_key(['order_id']) }} as order_key
+from {{ ref('stg_orders') }}`
+
+	query := ParseQuery(Parse(input, "").CreateTokenIndex().Tokens())
+	items := query.Selects[0].Items
+	if len(items) != 3 {
+		t.Fatalf("expected 3 items, got %d", len(items))
+	}
+
+	first := items[0]
+	if !first.Star || first.StarRelation == nil || first.StarRelation.Kind != TableModel || first.StarRelation.Name != "stg_orders" {
+		t.Errorf("unexpected star relation %+v", first.StarRelation)
+	}
+	if !reflect.DeepEqual(first.StarExcept, []string{"status", "amount"}) || first.StarPrefix != "o_" {
+		t.Errorf("unexpected star arguments %v %q", first.StarExcept, first.StarPrefix)
+	}
+
+	second := items[1]
+	if !second.Star || second.StarRelation == nil || second.StarRelation.Kind != TableSource ||
+		second.StarRelation.Source != "raw" || second.StarRelation.Name != "payments" || second.StarSuffix != "_p" {
+		t.Errorf("unexpected star item %+v", second)
+	}
+
+	if items[2].Star {
+		t.Error("expected generate_surrogate_key not to be a star")
+	}
+	if name, ok := items[2].OutputName(); !ok || name.Literal != "order_key" {
+		t.Errorf("expected order_key, got %v", name)
+	}
+}
+
+func TestParseQueryRefArguments(t *testing.T) {
+	input := `select * from {{ ref('shared', 'orders') }} o
+join {{ ref('dim_customers', v=1) }} c on o.id = c.id
+join {{ ref('shared', 'customers', version=2) }} on true`
+
+	query := ParseQuery(Parse(input, "").CreateTokenIndex().Tokens())
+	expected := []TableRef{
+		{Kind: TableModel, Package: "shared", Name: "orders", Alias: "o"},
+		{Kind: TableModel, Name: "dim_customers", Version: "1", Alias: "c"},
+		{Kind: TableModel, Package: "shared", Name: "customers", Version: "2"},
+	}
+	from := query.Selects[0].From
+	if len(from) != len(expected) {
+		t.Fatalf("expected %d tables, got %d", len(expected), len(from))
+	}
+	for i, table := range from {
+		actual := *table
+		actual.Token = Token{}
+		if !reflect.DeepEqual(actual, expected[i]) {
+			t.Errorf("expected %+v, got %+v", expected[i], actual)
+		}
+	}
+}
+
+func TestParseQueryUnterminatedJinja(t *testing.T) {
+	for _, input := range []string{"select {{", "select {%", "select a, {{ ref(", "select a from {%"} {
+		query := ParseQuery(Parse(input, "").CreateTokenIndex().Tokens())
+		if query == nil || len(query.Selects) != 1 {
+			t.Errorf("expected a select for %q, got %s", input, fmt.Sprint(query))
+		}
+	}
+}
@@ -8,7 +8,9 @@ type Token struct {
 	Type    TokenType
 	Literal string
 	Line    int
-	Column  int
+	// Column is a byte offset. Positions from the client are converted to
+	// bytes before tokens are looked up.
+	Column int
 }
 
 const (
@@ -55,6 +57,8 @@ const (
 	VAR          = "VAR"
 	SOURCE       = "SOURCE"
 	SOURCE_TABLE = "SOURCE_TABLE"
+	METRIC       = "METRIC"
+	VERSION      = "VERSION"
 	MACRO        = "MACRO"
 	PACKAGE      = "PACKAGE"
 	CONFIG       = "CONFIG"
@@ -365,20 +369,28 @@ var duckdbKeywords = map[string]TokenType{
 	"with":         WITH,
 }
 
+// dbtKeywords are looked up before the dialect's keywords. They're kept
+// apart so LookupIdent never writes to the shared keyword maps, which the
+// parser reads from concurrent requests.
+var dbtKeywords = map[string]TokenType{
+	"ref":    REF,
+	"var":    VAR,
+	"source": SOURCE,
+	"config": CONFIG,
+}
+
 func LookupIdent(ident string, dialect docs.Dialect) TokenType {
-	keywords := map[string]TokenType{}
+	if tok, ok := dbtKeywords[ident]; ok {
+		return tok
+	}
+
+	var keywords map[string]TokenType
 	switch dialect {
 	case "snowflake":
 		keywords = snowflakeKeywords
 	case "duckdb":
 		keywords = duckdbKeywords
 	}
-
-	// dbt keywords
-	keywords["ref"] = REF
-	keywords["var"] = VAR
-	keywords["source"] = SOURCE
-	keywords["config"] = CONFIG
 	if tok, ok := keywords[ident]; ok {
 		return tok
 	}
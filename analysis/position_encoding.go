@@ -0,0 +1,305 @@
+package analysis
+
+import (
+	"strings"
+
+	"github.com/j-clemons/dbt-language-server/lsp"
+)
+
+// PositionEncoding is the unit a client counts the character offset of a
+// position in. Documents are indexed by byte offset, so positions are
+// converted where they enter and leave the server.
+type PositionEncoding string
+
+const (
+	UTF8  PositionEncoding = "utf-8"
+	UTF16 PositionEncoding = "utf-16"
+	UTF32 PositionEncoding = "utf-32"
+)
+
+// NegotiatePositionEncoding picks an encoding the client supports. UTF-8
+// needs no conversion so it's preferred. Clients that don't list any
+// support UTF-16, the protocol's default.
+func NegotiatePositionEncoding(supported []string) PositionEncoding {
+	for _, encoding := range supported {
+		if PositionEncoding(encoding) == UTF8 {
+			return UTF8
+		}
+	}
+	for _, encoding := range supported {
+		switch PositionEncoding(encoding) {
+		case UTF16, UTF32:
+			return PositionEncoding(encoding)
+		}
+	}
+	return UTF16
+}
+
+func (s *State) SetPositionEncoding(encoding PositionEncoding) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.positionEncoding = encoding
+}
+
+func (s *State) PositionEncoding() PositionEncoding {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.positionEncoding
+}
+
+// codeUnits is the length of r in encoding.
+func codeUnits(r rune, encoding PositionEncoding) int {
+	if encoding == UTF16 && r >= 0x10000 {
+		return 2
+	}
+	return 1
+}
+
+// byteOffset converts a character offset on line, in encoding, to a byte
+// offset. Offsets past the end of the line stay past it.
+func byteOffset(line string, character int, encoding PositionEncoding) int {
+	if encoding == UTF8 || character <= 0 {
+		return character
+	}
+	units := 0
+	for i, r := range line {
+		if units >= character {
+			return i
+		}
+		units += codeUnits(r, encoding)
+	}
+	return len(line) + character - units
+}
+
+// characterOffset converts a byte offset on line to a character offset in
+// encoding. Offsets past the end of the line stay past it.
+func characterOffset(line string, offset int, encoding PositionEncoding) int {
+	if encoding == UTF8 || offset <= 0 {
+		return offset
+	}
+	overflow := 0
+	if offset > len(line) {
+		overflow = offset - len(line)
+		offset = len(line)
+	}
+	units := 0
+	for _, r := range line[:offset] {
+		units += codeUnits(r, encoding)
+	}
+	return units + overflow
+}
+
+func lineAt(lines []string, line int) string {
+	if line < 0 || line >= len(lines) {
+		return ""
+	}
+	return lines[line]
+}
+
+// DecodePosition converts a position the client sent for the document at
+// uri to the byte offset the server indexes by.
+func (s *State) DecodePosition(uri string, position lsp.Position) lsp.Position {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.positionEncoding == UTF8 {
+		return position
+	}
+	text, _ := s.documentText(strings.TrimPrefix(uri, "file://"))
+	line := lineAt(strings.Split(text, "\n"), position.Line)
+	position.Character = byteOffset(line, position.Character, s.positionEncoding)
+	return position
+}
+
+// positionEncoder converts byte positions to the client's encoding,
+// reading each file's lines once.
+type positionEncoder struct {
+	s     *State
+	lines map[string][]string
+}
+
+func (e *positionEncoder) position(uri string, position lsp.Position) lsp.Position {
+	lines, ok := e.lines[uri]
+	if !ok {
+		text, _ := e.s.documentText(strings.TrimPrefix(uri, "file://"))
+		lines = strings.Split(text, "\n")
+		e.lines[uri] = lines
+	}
+	position.Character = characterOffset(lineAt(lines, position.Line), position.Character, e.s.positionEncoding)
+	return position
+}
+
+func (e *positionEncoder) rangeIn(uri string, r lsp.Range) lsp.Range {
+	return lsp.Range{Start: e.position(uri, r.Start), End: e.position(uri, r.End)}
+}
+
+func (e *positionEncoder) location(location lsp.Location) lsp.Location {
+	location.Range = e.rangeIn(location.URI, location.Range)
+	return location
+}
+
+func (e *positionEncoder) callHierarchyItem(item lsp.CallHierarchyItem) lsp.CallHierarchyItem {
+	item.Range = e.rangeIn(item.URI, item.Range)
+	item.SelectionRange = e.rangeIn(item.URI, item.SelectionRange)
+	return item
+}
+
+// signatures converts the parameter labels, which are offsets into each
+// signature's label rather than a document.
+func (e *positionEncoder) signatures(signatures []lsp.SignatureInformation) []lsp.SignatureInformation {
+	encoded := make([]lsp.SignatureInformation, len(signatures))
+	for i, signature := range signatures {
+		params := make([]lsp.ParameterInformation, len(signature.Parameters))
+		for j, param := range signature.Parameters {
+			param.Label = [2]int{
+				characterOffset(signature.Label, param.Label[0], e.s.positionEncoding),
+				characterOffset(signature.Label, param.Label[1], e.s.positionEncoding),
+			}
+			params[j] = param
+		}
+		signature.Parameters = params
+		encoded[i] = signature
+	}
+	return encoded
+}
+
+func (e *positionEncoder) documentSymbols(uri string, symbols []lsp.DocumentSymbol) []lsp.DocumentSymbol {
+	if symbols == nil {
+		return nil
+	}
+	encoded := make([]lsp.DocumentSymbol, len(symbols))
+	for i, symbol := range symbols {
+		symbol.Range = e.rangeIn(uri, symbol.Range)
+		symbol.SelectionRange = e.rangeIn(uri, symbol.SelectionRange)
+		symbol.Children = e.documentSymbols(uri, symbol.Children)
+		encoded[i] = symbol
+	}
+	return encoded
+}
+
+// EncodePositions converts the byte positions in a response, or a
+// diagnostics notification, to the client's encoding. uri is the document
+// the request was for, which holds the positions that don't name a file.
+func (s *State) EncodePositions(uri string, msg any) any {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.positionEncoding == UTF8 {
+		return msg
+	}
+	e := &positionEncoder{s: s, lines: map[string][]string{}}
+
+	switch m := msg.(type) {
+	case lsp.DefinitionResponse:
+		m.Result = e.location(m.Result)
+		return m
+	case lsp.ExecuteCommandResponse:
+		if location, ok := m.Result.(lsp.Location); ok {
+			m.Result = e.location(location)
+		}
+		return m
+	case lsp.ReferencesResponse:
+		locations := make([]lsp.Location, len(m.Result))
+		for i, location := range m.Result {
+			locations[i] = e.location(location)
+		}
+		m.Result = locations
+		return m
+	case lsp.WorkspaceSymbolResponse:
+		symbols := make([]lsp.SymbolInformation, len(m.Result))
+		for i, symbol := range m.Result {
+			symbol.Location = e.location(symbol.Location)
+			symbols[i] = symbol
+		}
+		m.Result = symbols
+		return m
+	case lsp.DocumentSymbolResponse:
+		m.Result = e.documentSymbols(uri, m.Result)
+		return m
+	case lsp.ColumnLineageResponse:
+		lineage := make([]lsp.ColumnLineage, len(m.Result))
+		for i, column := range m.Result {
+			column.Range = e.rangeIn(uri, column.Range)
+			lineage[i] = column
+		}
+		m.Result = lineage
+		return m
+	case lsp.PrepareRenameResponse:
+		if m.Result != nil {
+			result := *m.Result
+			result.Range = e.rangeIn(uri, result.Range)
+			m.Result = &result
+		}
+		return m
+	case lsp.RenameResponse:
+		if m.Result != nil {
+			changes := make([]any, len(m.Result.DocumentChanges))
+			for i, change := range m.Result.DocumentChanges {
+				if edit, ok := change.(lsp.TextDocumentEdit); ok {
+					edits := make([]lsp.TextEdit, len(edit.Edits))
+					for j, textEdit := range edit.Edits {
+						textEdit.Range = e.rangeIn(edit.TextDocument.URI, textEdit.Range)
+						edits[j] = textEdit
+					}
+					edit.Edits = edits
+					change = edit
+				}
+				changes[i] = change
+			}
+			m.Result = &lsp.WorkspaceEdit{DocumentChanges: changes}
+		}
+		return m
+	case lsp.CallHierarchyPrepareResponse:
+		if m.Result != nil {
+			items := make([]lsp.CallHierarchyItem, len(m.Result))
+			for i, item := range m.Result {
+				items[i] = e.callHierarchyItem(item)
+			}
+			m.Result = items
+		}
+		return m
+	case lsp.CallHierarchyIncomingCallsResponse:
+		calls := make([]lsp.CallHierarchyIncomingCall, len(m.Result))
+		for i, call := range m.Result {
+			ranges := make([]lsp.Range, len(call.FromRanges))
+			for j, r := range call.FromRanges {
+				ranges[j] = e.rangeIn(call.From.URI, r)
+			}
+			call.From = e.callHierarchyItem(call.From)
+			call.FromRanges = ranges
+			calls[i] = call
+		}
+		m.Result = calls
+		return m
+	case lsp.CallHierarchyOutgoingCallsResponse:
+		// the calls are made from the item the request was for
+		calls := make([]lsp.CallHierarchyOutgoingCall, len(m.Result))
+		for i, call := range m.Result {
+			ranges := make([]lsp.Range, len(call.FromRanges))
+			for j, r := range call.FromRanges {
+				ranges[j] = e.rangeIn(uri, r)
+			}
+			call.To = e.callHierarchyItem(call.To)
+			call.FromRanges = ranges
+			calls[i] = call
+		}
+		m.Result = calls
+		return m
+	case lsp.SignatureHelpResponse:
+		if m.Result != nil {
+			help := *m.Result
+			help.Signatures = e.signatures(help.Signatures)
+			m.Result = &help
+		}
+		return m
+	case lsp.DiagnosticsNotification:
+		diagnostics := make([]lsp.Diagnostic, len(m.Params.Diagnostics))
+		for i, diagnostic := range m.Params.Diagnostics {
+			diagnostic.Range = e.rangeIn(m.Params.URI, diagnostic.Range)
+			diagnostics[i] = diagnostic
+		}
+		m.Params.Diagnostics = diagnostics
+		return m
+	}
+	return msg
+}
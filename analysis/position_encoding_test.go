@@ -0,0 +1,133 @@
+package analysis
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/j-clemons/dbt-language-server/lsp"
+)
+
+func TestNegotiatePositionEncoding(t *testing.T) {
+	tests := []struct {
+		supported []string
+		expected  PositionEncoding
+	}{
+		{nil, UTF16},
+		{[]string{"utf-16"}, UTF16},
+		{[]string{"utf-32", "utf-16"}, UTF32},
+		{[]string{"utf-16", "utf-8"}, UTF8},
+		{[]string{"latin-1"}, UTF16},
+	}
+	for _, tt := range tests {
+		if actual := NegotiatePositionEncoding(tt.supported); actual != tt.expected {
+			t.Errorf("%v: expected %s, got %s", tt.supported, tt.expected, actual)
+		}
+	}
+}
+
+func TestPositionOffsets(t *testing.T) {
+	// é is 2 bytes and 1 UTF-16 unit, 😀 is 4 bytes and 2 UTF-16 units
+	line := "select 'é😀' as café"
+
+	tests := []struct {
+		encoding  PositionEncoding
+		character int
+		offset    int
+	}{
+		{UTF8, 9, 9},
+		{UTF16, 8, 8},
+		{UTF16, 9, 10},
+		{UTF16, 11, 14},
+		{UTF16, 20, 24},
+		{UTF16, 22, 26}, // past the end of the line
+		{UTF32, 10, 14},
+		{UTF32, 19, 24},
+	}
+	for _, tt := range tests {
+		if actual := byteOffset(line, tt.character, tt.encoding); actual != tt.offset {
+			t.Errorf("%s: expected character %d at byte %d, got %d", tt.encoding, tt.character, tt.offset, actual)
+		}
+		if actual := characterOffset(line, tt.offset, tt.encoding); actual != tt.character {
+			t.Errorf("%s: expected byte %d at character %d, got %d", tt.encoding, tt.offset, tt.character, actual)
+		}
+	}
+}
+
+func TestIncrementalChangeUTF16(t *testing.T) {
+	state := NewState()
+	uri := "test://document.sql"
+	state.UpdateDocument(uri, "select '😀' as émoji,\n  1 as id")
+
+	// replace "émoji" with "e", counted in UTF-16 units
+	state.UpdateDocumentIncremental(uri, []lsp.TextDocumentContentChangeEvent{
+		{
+			Range: lsp.Range{
+				Start: lsp.Position{Line: 0, Character: 15},
+				End:   lsp.Position{Line: 0, Character: 20},
+			},
+			Text: "e",
+		},
+	})
+
+	if expected := "select '😀' as e,\n  1 as id"; state.Documents[uri].Text != expected {
+		t.Errorf("expected %q, got %q", expected, state.Documents[uri].Text)
+	}
+}
+
+func TestEncodePositions(t *testing.T) {
+	state, testdataRoot := yamlTestState(t)
+	uri := "file://" + filepath.Join(testdataRoot, "models/new_model.sql")
+	state.parseDocument(uri, "select '😀' as a, * from {{ ref('missing') }}")
+
+	// the model name starts at byte 35, or UTF-16 unit 33
+	diagnostics := lsp.NewDiagnosticsNotification(uri, state.Diagnostics(uri))
+	encoded := state.EncodePositions(uri, diagnostics).(lsp.DiagnosticsNotification)
+	if start := encoded.Params.Diagnostics[0].Range.Start; start.Character != 33 {
+		t.Errorf("expected the diagnostic at character 33, got %v", start)
+	}
+	if start := diagnostics.Params.Diagnostics[0].Range.Start; start.Character != 35 {
+		t.Errorf("expected the original diagnostic to be unchanged, got %v", start)
+	}
+
+	position := state.DecodePosition(uri, lsp.Position{Line: 0, Character: 34})
+	if position.Character != 36 {
+		t.Errorf("expected byte 36, got %v", position)
+	}
+	if token, err := state.Documents[uri].Tokens.FindTokenAtCursor(position.Line, position.Character); err != nil || token.Token.Literal != "missing" {
+		t.Errorf("expected the decoded position to be on the ref, got %v (%v)", token, err)
+	}
+
+	state.SetPositionEncoding(UTF8)
+	if actual := state.EncodePositions(uri, diagnostics).(lsp.DiagnosticsNotification); actual.Params.Diagnostics[0].Range.Start.Character != 35 {
+		t.Errorf("expected UTF-8 positions to be left as bytes, got %v", actual.Params.Diagnostics[0].Range)
+	}
+}
+
+func TestEncodeSignatureHelp(t *testing.T) {
+	state := NewState()
+	// é is two bytes, one UTF-16 unit
+	response := lsp.SignatureHelpResponse{
+		Result: &lsp.SignatureHelp{
+			Signatures: []lsp.SignatureInformation{{
+				Label: "f(café, b)",
+				Parameters: []lsp.ParameterInformation{
+					{Label: [2]int{2, 7}},
+					{Label: [2]int{9, 10}},
+				},
+			}},
+		},
+	}
+
+	encoded := state.EncodePositions("", response).(lsp.SignatureHelpResponse)
+	expected := []lsp.ParameterInformation{
+		{Label: [2]int{2, 6}},
+		{Label: [2]int{8, 9}},
+	}
+	if actual := encoded.Result.Signatures[0].Parameters; !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+	if original := response.Result.Signatures[0].Parameters[0].Label; original != [2]int{2, 7} {
+		t.Errorf("expected the original labels to be unchanged, got %v", original)
+	}
+}
@@ -0,0 +1,271 @@
+package analysis
+
+import "strings"
+
+// yamlType is a set of YAML value kinds a key accepts.
+type yamlType int
+
+const (
+	yamlString yamlType = 1 << iota
+	yamlBool
+	yamlNumber
+	yamlList
+	yamlMapping
+
+	yamlAny = yamlString | yamlBool | yamlNumber | yamlList | yamlMapping
+)
+
+func (t yamlType) String() string {
+	names := []string{}
+	for _, kind := range []struct {
+		t    yamlType
+		name string
+	}{
+		{yamlString, "a string"},
+		{yamlBool, "a boolean"},
+		{yamlNumber, "a number"},
+		{yamlList, "a list"},
+		{yamlMapping, "a mapping"},
+	} {
+		if t&kind.t != 0 {
+			names = append(names, kind.name)
+		}
+	}
+	if len(names) <= 1 {
+		return strings.Join(names, "")
+	}
+	return strings.Join(names[:len(names)-1], ", ") + " or " + names[len(names)-1]
+}
+
+// schemaKey describes a key of dbt_project.yml.
+type schemaKey struct {
+	Type        yamlType
+	Description string
+	// Values are suggested for string values, e.g. materializations.
+	Values []string
+	// Keys are the known keys of a mapping, or of each mapping in a list.
+	// A mapping without Keys accepts any key.
+	Keys map[string]*schemaKey
+	// Configs makes this a resource config tree like `models:`, where each
+	// key is a config, optionally prefixed with +, or a package or folder
+	// name holding a nested tree.
+	Configs map[string]*schemaKey
+	// Paths is the dbt_project.yml key listing the folders a config tree
+	// applies to, e.g. model-paths.
+	Paths string
+}
+
+func key(t yamlType, description string) *schemaKey {
+	return &schemaKey{Type: t, Description: description}
+}
+
+func enumKey(description string, values ...string) *schemaKey {
+	return &schemaKey{Type: yamlString, Description: description, Values: values}
+}
+
+func mappingKey(description string, keys map[string]*schemaKey) *schemaKey {
+	return &schemaKey{Type: yamlMapping, Description: description, Keys: keys}
+}
+
+func configTree(description string, paths string, configs ...map[string]*schemaKey) *schemaKey {
+	merged := make(map[string]*schemaKey)
+	for _, c := range configs {
+		for name, k := range c {
+			merged[name] = k
+		}
+	}
+	return &schemaKey{Type: yamlMapping, Description: description, Configs: merged, Paths: paths}
+}
+
+// generalConfigs apply to every resource type.
+var generalConfigs = map[string]*schemaKey{
+	"enabled": key(yamlBool, "Whether the resource is parsed and built. Disabled resources can't be referenced."),
+	"tags":    key(yamlString|yamlList, "Tags to select resources by, e.g. `dbt run --select tag:nightly`."),
+	"meta":    mappingKey("Arbitrary metadata, shown in the docs site and the manifest.", nil),
+	"docs": mappingKey("Docs site settings.", map[string]*schemaKey{
+		"show":       key(yamlBool, "Whether the resource is shown in the docs site."),
+		"node_color": key(yamlString, "Color of the node in the docs site DAG, a name or hex code."),
+	}),
+	"group": key(yamlString, "The group that owns the resource."),
+}
+
+var relationConfigs = map[string]*schemaKey{
+	"database": key(yamlString, "Database the relation is built in, through the `generate_database_name` macro."),
+	"schema":   key(yamlString, "Custom schema, appended to the target schema by `generate_schema_name` by default."),
+	"alias":    key(yamlString, "Name of the relation in the warehouse, defaulting to the file name."),
+	"persist_docs": mappingKey("Persist descriptions as comments in the warehouse.", map[string]*schemaKey{
+		"relation": key(yamlBool, "Persist the resource description on the relation."),
+		"columns":  key(yamlBool, "Persist column descriptions on the columns."),
+	}),
+	"pre-hook":     key(yamlString|yamlList, "SQL run before the resource is built."),
+	"post-hook":    key(yamlString|yamlList, "SQL run after the resource is built."),
+	"full_refresh": key(yamlBool, "Force (`true`) or prevent (`false`) full refreshes, overriding `--full-refresh`."),
+	"grants":       mappingKey("Privileges granted on the relation, e.g. `select: ['reporter']`.", nil),
+	"quoting": mappingKey("Whether identifiers are quoted.", map[string]*schemaKey{
+		"database":   key(yamlBool, "Quote the database name."),
+		"schema":     key(yamlBool, "Quote the schema name."),
+		"identifier": key(yamlBool, "Quote the relation name."),
+	}),
+	"sql_header": key(yamlString, "SQL injected before the `create` statement."),
+}
+
+var modelConfigs = map[string]*schemaKey{
+	"materialized": enumKey("How the model is built in the warehouse.", "view", "table", "incremental", "ephemeral", "materialized_view"),
+	"incremental_strategy": enumKey(
+		"How incremental models merge new rows.",
+		"append", "merge", "delete+insert", "insert_overwrite", "microbatch",
+	),
+	"unique_key":         key(yamlString|yamlList, "Column, or columns, identifying a row for incremental merges."),
+	"on_schema_change":   enumKey("What incremental models do when columns change.", "ignore", "fail", "append_new_columns", "sync_all_columns"),
+	"access":             enumKey("Which models may `ref` this one.", "private", "protected", "public"),
+	"contract":           mappingKey("Enforce the documented columns and data types.", map[string]*schemaKey{"enforced": key(yamlBool, "Whether the contract is enforced."), "alias_types": key(yamlBool, "Whether data type aliases are resolved.")}),
+	"event_time":         key(yamlString, "Column holding the time of each row, used by microbatch models and sample mode."),
+	"batch_size":         enumKey("Period covered by each microbatch.", "hour", "day", "month", "year"),
+	"lookback":           key(yamlNumber, "Number of earlier batches microbatch models reprocess."),
+	"begin":              key(yamlString, "Start of the first microbatch."),
+	"concurrent_batches": key(yamlBool, "Whether microbatches run concurrently."),
+}
+
+var seedConfigs = map[string]*schemaKey{
+	"column_types":  mappingKey("Data types of seed columns, keyed by column name.", nil),
+	"quote_columns": key(yamlBool, "Whether seed column names are quoted."),
+	"delimiter":     key(yamlString, "Field separator of the CSV file."),
+}
+
+var snapshotConfigs = map[string]*schemaKey{
+	"strategy":                enumKey("How changed rows are detected.", "timestamp", "check"),
+	"unique_key":              key(yamlString|yamlList, "Column, or columns, identifying a record."),
+	"updated_at":              key(yamlString, "Timestamp column used by the timestamp strategy."),
+	"check_cols":              key(yamlString|yamlList, "Columns compared by the check strategy, or `all`."),
+	"target_schema":           key(yamlString, "Schema snapshots are written to."),
+	"target_database":         key(yamlString, "Database snapshots are written to."),
+	"hard_deletes":            enumKey("How rows deleted from the source are recorded.", "ignore", "invalidate", "new_record"),
+	"invalidate_hard_deletes": key(yamlBool, "Deprecated, use `hard_deletes: invalidate`."),
+	"dbt_valid_to_current":    key(yamlString, "Value of `dbt_valid_to` for current records instead of null."),
+	"snapshot_meta_column_names": mappingKey("Names of the snapshot metadata columns.", map[string]*schemaKey{
+		"dbt_valid_from": key(yamlString, "Name of the `dbt_valid_from` column."),
+		"dbt_valid_to":   key(yamlString, "Name of the `dbt_valid_to` column."),
+		"dbt_scd_id":     key(yamlString, "Name of the `dbt_scd_id` column."),
+		"dbt_updated_at": key(yamlString, "Name of the `dbt_updated_at` column."),
+		"dbt_is_deleted": key(yamlString, "Name of the `dbt_is_deleted` column."),
+	}),
+}
+
+var testConfigs = map[string]*schemaKey{
+	"severity":          enumKey("Whether failures are errors or warnings.", "error", "warn"),
+	"warn_if":           key(yamlString, "Condition on the failure count that raises a warning, e.g. `>10`."),
+	"error_if":          key(yamlString, "Condition on the failure count that raises an error."),
+	"fail_calc":         key(yamlString, "Expression computing the failure count, `count(*)` by default."),
+	"limit":             key(yamlNumber, "Maximum number of failing rows returned."),
+	"where":             key(yamlString, "Filter applied to the tested relation."),
+	"store_failures":    key(yamlBool, "Store failing rows in the warehouse."),
+	"store_failures_as": enumKey("Relation type failing rows are stored as.", "table", "view", "ephemeral"),
+	"schema":            key(yamlString, "Schema failing rows are stored in."),
+	"database":          key(yamlString, "Database failing rows are stored in."),
+	"alias":             key(yamlString, "Name of the relation failing rows are stored in."),
+}
+
+var sourceConfigs = map[string]*schemaKey{
+	"event_time":      key(yamlString, "Column holding the time of each row."),
+	"loaded_at_field": key(yamlString, "Column used to check freshness."),
+	"freshness": mappingKey("Freshness thresholds.", map[string]*schemaKey{
+		"warn_after":  mappingKey("Age that raises a warning, e.g. `{count: 12, period: hour}`.", nil),
+		"error_after": mappingKey("Age that raises an error.", nil),
+		"filter":      key(yamlString, "Filter applied when checking freshness."),
+	}),
+}
+
+var searchOrderKeys = map[string]*schemaKey{
+	"macro_namespace": key(yamlString, "Package whose macros are dispatched, e.g. `dbt_utils`."),
+	"search_order":    key(yamlList, "Packages searched for implementations, in order."),
+}
+
+var flagKeys = map[string]*schemaKey{
+	"send_anonymous_usage_stats": key(yamlBool, "Send anonymous usage statistics to dbt Labs."),
+	"use_colors":                 key(yamlBool, "Colorize terminal output."),
+	"use_colors_file":            key(yamlBool, "Colorize the log file."),
+	"partial_parse":              key(yamlBool, "Reuse the previous parse for unchanged files."),
+	"printer_width":              key(yamlNumber, "Width of terminal output."),
+	"write_json":                 key(yamlBool, "Write manifest.json and run_results.json."),
+	"warn_error":                 key(yamlBool, "Treat every warning as an error."),
+	"warn_error_options":         mappingKey("Warnings to treat as errors, or to silence.", nil),
+	"log_format":                 enumKey("Format of terminal logs.", "text", "debug", "json", "default"),
+	"log_format_file":            enumKey("Format of the log file.", "text", "debug", "json", "default"),
+	"log_level":                  enumKey("Minimum level of terminal logs.", "debug", "info", "warn", "error", "none"),
+	"log_level_file":             enumKey("Minimum level of the log file.", "debug", "info", "warn", "error", "none"),
+	"debug":                      key(yamlBool, "Show debug logging."),
+	"version_check":              key(yamlBool, "Check that the dbt version satisfies `require-dbt-version`."),
+	"fail_fast":                  key(yamlBool, "Stop at the first failure."),
+	"use_experimental_parser":    key(yamlBool, "Use the experimental parser."),
+	"static_parser":              key(yamlBool, "Parse simple models without rendering jinja."),
+	"cache_selected_only":        key(yamlBool, "Only cache the schemas of selected resources."),
+	"populate_cache":             key(yamlBool, "Populate the relation cache at the start of a run."),
+	"indirect_selection":         enumKey("Which tests are selected alongside their models.", "eager", "cautious", "buildable", "empty"),
+	"quiet":                      key(yamlBool, "Only log errors."),
+	"no_print":                   key(yamlBool, "Silence `print()` in macros."),
+	"require_explicit_package_overrides_for_builtin_materializations": key(yamlBool, "Require packages overriding builtin materializations to be listed explicitly."),
+	"require_resource_names_without_spaces":                           key(yamlBool, "Reject resource names containing spaces."),
+	"source_freshness_run_project_hooks":                              key(yamlBool, "Run on-run-start and on-run-end hooks for `dbt source freshness`."),
+	"require_generic_test_arguments_property":                         key(yamlBool, "Require generic test arguments to be nested under `arguments:`."),
+	"state_modified_compare_more_unrendered_values":                   key(yamlBool, "Compare unrendered configs when selecting `state:modified`."),
+	"skip_nodes_if_on_run_start_fails":                                key(yamlBool, "Skip all nodes when an on-run-start hook fails."),
+}
+
+// projectSchema describes dbt_project.yml.
+var projectSchema = mappingKey("", map[string]*schemaKey{
+	"name":                  key(yamlString, "Name of the project, used as the package name in `ref` and config trees."),
+	"version":               key(yamlString|yamlNumber, "Version of the project."),
+	"config-version":        key(yamlNumber, "Version of the dbt_project.yml format, always `2`."),
+	"profile":               key(yamlString, "Profile in profiles.yml used to connect to the warehouse."),
+	"require-dbt-version":   key(yamlString|yamlList, "dbt versions the project supports, e.g. `[\">=1.7.0\", \"<2.0.0\"]`."),
+	"model-paths":           key(yamlList, "Directories containing models. Defaults to `[\"models\"]`."),
+	"seed-paths":            key(yamlList, "Directories containing seeds. Defaults to `[\"seeds\"]`."),
+	"test-paths":            key(yamlList, "Directories containing singular tests and generic tests. Defaults to `[\"tests\"]`."),
+	"analysis-paths":        key(yamlList, "Directories containing analyses."),
+	"macro-paths":           key(yamlList, "Directories containing macros. Defaults to `[\"macros\"]`."),
+	"snapshot-paths":        key(yamlList, "Directories containing snapshots. Defaults to `[\"snapshots\"]`."),
+	"docs-paths":            key(yamlList, "Directories containing docs blocks. Defaults to every resource path."),
+	"asset-paths":           key(yamlList, "Directories copied into the docs site, e.g. images."),
+	"function-paths":        key(yamlList, "Directories containing user defined functions."),
+	"packages-install-path": key(yamlString, "Directory packages are installed in. Defaults to `dbt_packages`."),
+	"target-path":           key(yamlString, "Directory compiled SQL and artifacts are written to. Defaults to `target`."),
+	"log-path":              key(yamlString, "Directory logs are written to. Defaults to `logs`."),
+	"clean-targets":         key(yamlList, "Directories removed by `dbt clean`."),
+	"query-comment": &schemaKey{
+		Type:        yamlString | yamlMapping,
+		Description: "Comment added to every query dbt runs, or settings for it.",
+		Keys: map[string]*schemaKey{
+			"comment":   key(yamlString, "The comment, which may use jinja."),
+			"append":    key(yamlBool, "Add the comment after the query rather than before it."),
+			"job-label": key(yamlBool, "Add the comment as a BigQuery job label."),
+		},
+	},
+	"quoting": mappingKey("Whether database, schema and identifier names are quoted.", map[string]*schemaKey{
+		"database":              key(yamlBool, "Quote database names."),
+		"schema":                key(yamlBool, "Quote schema names."),
+		"identifier":            key(yamlBool, "Quote relation names."),
+		"snowflake_ignore_case": key(yamlBool, "Ignore case when quoting on Snowflake."),
+	}),
+	"on-run-start": key(yamlString|yamlList, "SQL or macro calls run at the start of `dbt run`, `build`, `test`, `seed` and `snapshot`."),
+	"on-run-end":   key(yamlString|yamlList, "SQL or macro calls run at the end of `dbt run`, `build`, `test`, `seed` and `snapshot`."),
+	"dispatch": {
+		Type:        yamlList,
+		Description: "Overrides the packages searched for dispatched macros, e.g. to use shims for another adapter.",
+		Keys:        searchOrderKeys,
+	},
+	"models":          configTree("Configs for models, nested by package and folder.", "model-paths", generalConfigs, relationConfigs, modelConfigs),
+	"seeds":           configTree("Configs for seeds, nested by package and folder.", "seed-paths", generalConfigs, relationConfigs, seedConfigs),
+	"snapshots":       configTree("Configs for snapshots, nested by package and folder.", "snapshot-paths", generalConfigs, relationConfigs, snapshotConfigs),
+	"data_tests":      configTree("Configs for data tests, nested by package and folder.", "test-paths", generalConfigs, testConfigs),
+	"tests":           configTree("Configs for data tests. Deprecated, use `data_tests`.", "test-paths", generalConfigs, testConfigs),
+	"unit_tests":      configTree("Configs for unit tests, nested by package and folder.", "test-paths", generalConfigs),
+	"sources":         configTree("Configs for sources, nested by package, source and table.", "", generalConfigs, sourceConfigs),
+	"exposures":       configTree("Configs for exposures.", "", generalConfigs),
+	"metrics":         configTree("Configs for metrics.", "", generalConfigs),
+	"semantic-models": configTree("Configs for semantic models.", "", generalConfigs),
+	"saved-queries":   configTree("Configs for saved queries.", "", generalConfigs, map[string]*schemaKey{"cache": mappingKey("Caching settings.", nil), "export_as": enumKey("Relation type exports are written as.", "table", "view")}),
+	"vars":            mappingKey("Variables read with `var()`, global or scoped to a package.", nil),
+	"flags":           mappingKey("Behavior flags and global settings.", flagKeys),
+	"restrict-access": key(yamlBool, "Enforce model `access` across packages."),
+	"dbt-cloud":       mappingKey("dbt Cloud settings.", nil),
+})
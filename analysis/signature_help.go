@@ -0,0 +1,152 @@
+package analysis
+
+import (
+	"strings"
+
+	"github.com/j-clemons/dbt-language-server/docs"
+	"github.com/j-clemons/dbt-language-server/lsp"
+	"github.com/j-clemons/dbt-language-server/util"
+)
+
+func macroArgumentDocumentation(arg MacroArgument) string {
+	if arg.Type == "" {
+		return arg.Description
+	}
+	return strings.TrimSpace("(" + arg.Type + ") " + arg.Description)
+}
+
+func macroSignature(macro Macro) lsp.SignatureInformation {
+	signature := lsp.SignatureInformation{
+		Documentation: macro.Documentation,
+		Parameters:    []lsp.ParameterInformation{},
+	}
+
+	var label strings.Builder
+	label.WriteString(macro.Name + "(")
+	for i, arg := range macro.Arguments {
+		if i > 0 {
+			label.WriteString(", ")
+		}
+		argLabel := arg.Name
+		if arg.Default != "" {
+			argLabel += "=" + arg.Default
+		}
+		start := label.Len()
+		label.WriteString(argLabel)
+		signature.Parameters = append(signature.Parameters, lsp.ParameterInformation{
+			Label:         [2]int{start, label.Len()},
+			Documentation: macroArgumentDocumentation(arg),
+		})
+	}
+	label.WriteString(")")
+	signature.Label = label.String()
+
+	return signature
+}
+
+func activeMacroParameter(macro Macro, ctx callContext) int {
+	if keyword, ok := ctx.KeywordArgument(); ok {
+		for i, arg := range macro.Arguments {
+			if arg.Name == keyword {
+				return i
+			}
+		}
+	}
+	return ctx.ActiveParameter
+}
+
+func functionSignatures(f docs.FunctionDoc) []lsp.SignatureInformation {
+	signatures := []lsp.SignatureInformation{}
+	for _, overload := range f.Overloads {
+		signature := lsp.SignatureInformation{
+			Label:         overload,
+			Documentation: f.Description,
+			Parameters:    []lsp.ParameterInformation{},
+		}
+		for _, param := range docs.SignatureParameters(overload) {
+			signature.Parameters = append(signature.Parameters, lsp.ParameterInformation{
+				Label:         param,
+				Documentation: f.ParameterDescription(overload[param[0]:param[1]]),
+			})
+		}
+		signatures = append(signatures, signature)
+	}
+	return signatures
+}
+
+// activeFunctionSignature picks the first overload that accepts the active
+// parameter, clamping the parameter onto a trailing `...` for variadics.
+func activeFunctionSignature(signatures []lsp.SignatureInformation, activeParameter int) (int, int) {
+	for i, signature := range signatures {
+		params := signature.Parameters
+		if activeParameter < len(params) {
+			return i, activeParameter
+		}
+		if len(params) > 0 {
+			last := params[len(params)-1].Label
+			if strings.Contains(signature.Label[last[0]:last[1]], "...") {
+				return i, len(params) - 1
+			}
+		}
+	}
+	return 0, activeParameter
+}
+
+func (s *State) sqlFunctionSignatureHelp(ctx callContext) *lsp.SignatureHelp {
+	f, ok := s.DbtContext.Dialect.FunctionDocs()[strings.ToLower(ctx.Name)]
+	if !ok {
+		return nil
+	}
+
+	signatures := functionSignatures(f)
+	activeSignature, activeParameter := activeFunctionSignature(signatures, ctx.ActiveParameter)
+	return &lsp.SignatureHelp{
+		Signatures:      signatures,
+		ActiveSignature: activeSignature,
+		ActiveParameter: activeParameter,
+	}
+}
+
+func (s *State) SignatureHelp(id lsp.RequestID, uri string, position lsp.Position) lsp.SignatureHelpResponse {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	s = s.project(uri)
+
+	response := lsp.SignatureHelpResponse{
+		Response: lsp.Response{
+			RPC: "2.0",
+			ID:  id,
+		},
+		Result: nil,
+	}
+
+	doc, exists := s.Documents[uri]
+	if !exists {
+		return response
+	}
+
+	ctx, ok := findCallContext(doc.Text, util.GetOffset(doc.Text, position.Line, position.Character))
+	if !ok {
+		return response
+	}
+	if !ctx.InJinja {
+		response.Result = s.sqlFunctionSignatureHelp(ctx)
+		return response
+	}
+
+	packageName := ctx.Package
+	if packageName == "" {
+		packageName = s.DbtContext.ProjectYaml.ProjectName.Value
+	}
+	macro, ok := s.DbtContext.MacroDetailMap[Package(packageName)][ctx.Name]
+	if !ok {
+		return response
+	}
+
+	response.Result = &lsp.SignatureHelp{
+		Signatures:      []lsp.SignatureInformation{macroSignature(macro)},
+		ActiveSignature: 0,
+		ActiveParameter: activeMacroParameter(macro, ctx),
+	}
+	return response
+}
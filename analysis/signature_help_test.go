@@ -0,0 +1,149 @@
+package analysis
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/j-clemons/dbt-language-server/lsp"
+	"github.com/j-clemons/dbt-language-server/testutils"
+)
+
+func TestSignatureHelp(t *testing.T) {
+	testdataRoot, err := testutils.GetTestdataPath("jaffle_shop_duckdb")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	state := NewState()
+	state.refreshDbtContext(testdataRoot)
+
+	uri := "file:///scratch/models/signature.sql"
+	state.parseDocument(uri, `select
+    {{ full_name('first', 'last') }},
+    {{ jaffle_package.add_values(1, arg2=2) }},
+    {{ missing_macro(1) }},
+    coalesce(1, 2)`)
+
+	fullName := lsp.SignatureInformation{
+		Label:         "full_name(first_name, last_name)",
+		Documentation: "Concatenates a first and last name.",
+		Parameters: []lsp.ParameterInformation{
+			{Label: [2]int{10, 20}, Documentation: "(string) The given name column."},
+			{Label: [2]int{22, 31}, Documentation: "(string) The family name column."},
+		},
+	}
+	addValues := lsp.SignatureInformation{
+		Label: "add_values(arg1, arg2)",
+		Parameters: []lsp.ParameterInformation{
+			{Label: [2]int{11, 15}},
+			{Label: [2]int{17, 21}},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		position lsp.Position
+		expected *lsp.SignatureHelp
+	}{
+		{
+			name:     "first argument",
+			position: lsp.Position{Line: 1, Character: 17},
+			expected: &lsp.SignatureHelp{Signatures: []lsp.SignatureInformation{fullName}, ActiveParameter: 0},
+		},
+		{
+			name:     "second argument",
+			position: lsp.Position{Line: 1, Character: 27},
+			expected: &lsp.SignatureHelp{Signatures: []lsp.SignatureInformation{fullName}, ActiveParameter: 1},
+		},
+		{
+			name:     "package macro keyword argument",
+			position: lsp.Position{Line: 2, Character: 41},
+			expected: &lsp.SignatureHelp{Signatures: []lsp.SignatureInformation{addValues}, ActiveParameter: 1},
+		},
+		{
+			name:     "unknown macro",
+			position: lsp.Position{Line: 3, Character: 21},
+			expected: nil,
+		},
+		{
+			name:     "outside jinja",
+			position: lsp.Position{Line: 4, Character: 16},
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			response := state.SignatureHelp(1, uri, tt.position)
+			if !reflect.DeepEqual(response.Result, tt.expected) {
+				t.Fatalf("expected %+v,\n\ngot %+v", tt.expected, response.Result)
+			}
+		})
+	}
+}
+
+func TestSqlFunctionSignatureHelp(t *testing.T) {
+	state := NewState()
+	state.DbtContext.Dialect = "snowflake"
+
+	uri := "file:///scratch/models/functions.sql"
+	state.parseDocument(uri, `select
+    DATEADD(day, 2, order_date),
+    approx_count_distinct(a, b, c),
+    {{ dateadd('day', 2) }}`)
+
+	tests := []struct {
+		name            string
+		position        lsp.Position
+		signatures      []string
+		activeSignature int
+		activeParameter int
+		parameterDoc    string
+	}{
+		{
+			name:            "second argument",
+			position:        lsp.Position{Line: 1, Character: 18},
+			signatures:      []string{"DATEADD( <date_or_time_part>, <value>, <date_or_time_expr> )"},
+			activeParameter: 1,
+			parameterDoc:    "This is the number of units of time that you want to add.",
+		},
+		{
+			name:     "variadic overload",
+			position: lsp.Position{Line: 2, Character: 32},
+			signatures: []string{
+				"APPROX_COUNT_DISTINCT( [ DISTINCT ] <expr1>  [ , ... ] )",
+				"APPROX_COUNT_DISTINCT(*)",
+			},
+			activeParameter: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := state.SignatureHelp(1, uri, tt.position).Result
+			if result == nil {
+				t.Fatal("expected signature help, got nil")
+			}
+			labels := []string{}
+			for _, s := range result.Signatures {
+				labels = append(labels, s.Label)
+			}
+			if !reflect.DeepEqual(labels, tt.signatures) {
+				t.Fatalf("expected signatures %v, got %v", tt.signatures, labels)
+			}
+			if result.ActiveSignature != tt.activeSignature || result.ActiveParameter != tt.activeParameter {
+				t.Fatalf("expected active %d/%d, got %d/%d", tt.activeSignature, tt.activeParameter, result.ActiveSignature, result.ActiveParameter)
+			}
+			doc := result.Signatures[tt.activeSignature].Parameters[tt.activeParameter].Documentation
+			if !strings.HasPrefix(doc, tt.parameterDoc) {
+				t.Fatalf("expected parameter doc %q, got %q", tt.parameterDoc, doc)
+			}
+		})
+	}
+
+	// a jinja call never falls back to the SQL function of the same name
+	if result := state.SignatureHelp(1, uri, lsp.Position{Line: 3, Character: 21}).Result; result != nil {
+		t.Fatalf("expected no signature help for an unknown macro, got %+v", result)
+	}
+}
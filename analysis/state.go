@@ -14,28 +14,67 @@ import (
 )
 
 type State struct {
+	// mu guards Documents, DbtContext, FusionEnabled, the workspace folders,
+	// the projects, the lifecycle and the position encoding. The exported
+	// methods lock it, so requests can be handled concurrently.
 	mu                sync.RWMutex
 	Documents         map[string]Document
 	DbtContext        DbtContext
 	FusionEnabled     bool
 	FusionPath        string
 	LspClientRootPath string
+	WorkspaceFolders  []string
+	// projects holds the context of each dbt project a document was opened
+	// in, by project root. DbtContext is used for documents outside them.
+	projects         map[string]*DbtContext
+	lifecycle        Lifecycle
+	positionEncoding PositionEncoding
 }
 
+// Lifecycle is where the server is in the LSP lifecycle.
+type Lifecycle int
+
+const (
+	// Uninitialized servers only answer initialize.
+	Uninitialized Lifecycle = iota
+	Initialized
+	// ShutDown servers refuse every request, and wait for exit.
+	ShutDown
+)
+
 type Document struct {
 	Text      string
 	Tokens    *parser.TokenIndex
 	DefTokens map[string]parser.Token
+	// CTETokens are the names of the document's CTEs. Unlike DefTokens,
+	// CTEs that share a name each have an entry.
+	CTETokens []parser.Token
+	// Yaml is set for YAML files, which are also tokenized so ref() and
+	// source() calls in them resolve like they do in SQL.
+	Yaml *yamlDocument
 }
 
 type DbtContext struct {
-	ProjectRoot       string
-	ProjectYaml       DbtProjectYaml
-	Dialect           docs.Dialect
-	ModelDetailMap    map[string]ModelDetails
+	ProjectRoot    string
+	ProjectYaml    DbtProjectYaml
+	Dialect        docs.Dialect
+	ModelDetailMap map[string]ModelDetails
+	// PackageModelMap holds the models of every project by package, for
+	// ref('package', 'model'). ModelDetailMap is keyed by name only and
+	// prefers the root project's models.
+	PackageModelMap   map[Package]map[string]ModelDetails
 	SourceDetailMap   map[string]Source
 	MacroDetailMap    map[Package]map[string]Macro
 	VariableDetailMap map[string]Variable
+	DocsDetailMap     map[Package]map[string]Docs
+	ReferenceIndex    map[ReferenceKey][]Reference
+	// GenericTestMap holds generic tests by package and the name used in
+	// properties files, SingularTestMap the path of each singular test.
+	GenericTestMap  map[Package]map[string]Macro
+	SingularTestMap map[string]string
+	// SemanticDetailMap holds exposures, metrics, semantic models, saved
+	// queries and groups by kind and name.
+	SemanticDetailMap map[SemanticKind]map[string]SemanticNode
 }
 
 func NewState() State {
@@ -46,13 +85,22 @@ func NewState() State {
 			ProjectYaml:       DbtProjectYaml{},
 			Dialect:           "",
 			ModelDetailMap:    map[string]ModelDetails{},
+			PackageModelMap:   map[Package]map[string]ModelDetails{},
 			SourceDetailMap:   map[string]Source{},
 			MacroDetailMap:    map[Package]map[string]Macro{},
 			VariableDetailMap: map[string]Variable{},
+			DocsDetailMap:     map[Package]map[string]Docs{},
+			ReferenceIndex:    map[ReferenceKey][]Reference{},
+			GenericTestMap:    map[Package]map[string]Macro{},
+			SingularTestMap:   map[string]string{},
+			SemanticDetailMap: map[SemanticKind]map[string]SemanticNode{},
 		},
 		FusionEnabled:     false,
 		FusionPath:        "",
 		LspClientRootPath: "",
+		WorkspaceFolders:  []string{},
+		projects:          map[string]*DbtContext{},
+		positionEncoding:  UTF16,
 	}
 }
 
@@ -63,32 +111,84 @@ func (s *State) SetFusionEnabled(enabled bool) {
 }
 
 func (s *State) IsFusionEnabled() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.FusionEnabled
+}
+
+func (s *State) SetLifecycle(lifecycle Lifecycle) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
-	return s.FusionEnabled
+	s.lifecycle = lifecycle
+}
+
+func (s *State) Lifecycle() Lifecycle {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.lifecycle
+}
+
+// ProjectName is the name of the dbt project the document at uri is in.
+func (s *State) ProjectName(uri string) string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.project(uri).DbtContext.ProjectYaml.ProjectName.Value
+}
+
+// ProjectRoot is the root of the dbt project the document at uri is in.
+func (s *State) ProjectRoot(uri string) string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.project(uri).DbtContext.ProjectRoot
+}
+
+func (s *State) SetLspClientRootPath(path string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.LspClientRootPath = path
 }
 
 func (s *State) refreshDbtContext(wd string) {
-	s.DbtContext.ProjectRoot = util.GetProjectRoot("dbt_project.yml", wd)
+	s.loadDbtContext(util.GetProjectRoot("dbt_project.yml", wd), wd)
+}
+
+// loadDbtContext parses the dbt project at projectRoot. The profiles are
+// looked up from wd.
+func (s *State) loadDbtContext(projectRoot, wd string) {
+	s.DbtContext.ProjectRoot = projectRoot
 
 	s.DbtContext.ProjectYaml = parseDbtProjectYaml(s.DbtContext.ProjectRoot)
 	s.DbtContext.Dialect = util.GetDialect(s.DbtContext.ProjectYaml.Profile.Value, wd)
 
 	var wg sync.WaitGroup
-	wg.Add(3)
+	wg.Add(5)
 
-	var modelMap map[string]ModelDetails
+	var packageModelMap map[Package]map[string]ModelDetails
 	var sourceMap map[string]Source
 	var macroMap map[Package]map[string]Macro
 	var varMap map[string]Variable
+	var docsMap map[Package]map[string]Docs
+	var referenceIndex map[ReferenceKey][]Reference
+
+	// a fresh manifest already has resolved configs and docs and lists every
+	// project file, otherwise the project paths are walked and parsed
+	manifest, useManifest := s.loadManifest()
 
 	go func() {
 		defer wg.Done()
-		modelMap, sourceMap = s.getModelDetails()
+		if useManifest {
+			packageModelMap, sourceMap = s.getManifestModelDetails(manifest)
+			return
+		}
+		packageModelMap, sourceMap = s.getModelDetails()
 	}()
 
 	go func() {
 		defer wg.Done()
+		if useManifest {
+			macroMap = s.getManifestMacroDetails(manifest)
+			return
+		}
 		macroMap = s.getMacroDetails()
 	}()
 
@@ -97,33 +197,86 @@ func (s *State) refreshDbtContext(wd string) {
 		varMap = s.getProjectVariables()
 	}()
 
+	go func() {
+		defer wg.Done()
+		if useManifest {
+			docsMap = s.getManifestDocsDetails(manifest)
+			return
+		}
+		docsMap = s.getDocsDetails()
+	}()
+
+	go func() {
+		defer wg.Done()
+		if useManifest {
+			referenceIndex = s.getManifestReferenceIndex(manifest)
+			return
+		}
+		referenceIndex = s.getReferenceIndex()
+	}()
+
 	wg.Wait()
 
+	if catalog, err := parseCatalog(catalogPath(s.DbtContext.ProjectRoot, s.DbtContext.ProjectYaml)); err == nil {
+		applyCatalog(catalog, packageModelMap, sourceMap)
+	}
+	modelMap := modelsByName(packageModelMap, s.DbtContext.ProjectYaml.ProjectName.Value)
+
 	s.DbtContext.ModelDetailMap = modelMap
+	s.DbtContext.PackageModelMap = packageModelMap
 	s.DbtContext.SourceDetailMap = sourceMap
 	s.DbtContext.MacroDetailMap = macroMap
 	s.DbtContext.VariableDetailMap = varMap
+	s.DbtContext.DocsDetailMap = docsMap
+	s.DbtContext.ReferenceIndex = referenceIndex
+
+	if useManifest {
+		s.DbtContext.GenericTestMap = s.getManifestGenericTestDetails(manifest, macroMap)
+		s.DbtContext.SingularTestMap = s.getManifestSingularTests(manifest)
+		s.DbtContext.SemanticDetailMap = s.getManifestSemanticDetails(manifest)
+		s.inferModelColumns(s.manifestModelCode(manifest))
+		return
+	}
+	s.DbtContext.GenericTestMap = s.getGenericTestDetails(macroMap)
+	s.DbtContext.SingularTestMap = s.getSingularTests()
+	s.DbtContext.SemanticDetailMap = s.getSemanticDetails()
+	s.inferModelColumns(nil)
 }
 
 func (s *State) parseDocument(uri, text string) {
 	parserIns := parser.Parse(text, s.DbtContext.Dialect)
-	s.Documents[uri] = Document{
+	doc := Document{
 		Text:      text,
 		Tokens:    parserIns.CreateTokenIndex(),
 		DefTokens: parserIns.CreateTokenNameMap(),
+		CTETokens: parserIns.CTETokens(),
+	}
+	if isYamlURI(uri) {
+		doc.Yaml = newYamlDocument(text)
+		doc.Yaml.Project = isProjectYamlURI(uri)
 	}
+	s.Documents[uri] = doc
 }
 
 func (s *State) OpenDocument(uri, text string) {
-	s.refreshDbtContext(s.LspClientRootPath)
-	s.parseDocument(uri, text)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.refreshProject(uri)
+	s.project(uri).parseDocument(uri, text)
 }
 
 func (s *State) UpdateDocument(uri, text string) {
-	s.parseDocument(uri, text)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.project(uri).parseDocument(uri, text)
 }
 
 func (s *State) UpdateDocumentIncremental(uri string, changes []lsp.TextDocumentContentChangeEvent) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
 	doc, exists := s.Documents[uri]
 	if !exists {
 		return
@@ -138,7 +291,7 @@ func (s *State) UpdateDocumentIncremental(uri string, changes []lsp.TextDocument
 		}
 	}
 
-	s.parseDocument(uri, currentText)
+	s.project(uri).parseDocument(uri, currentText)
 }
 
 func (s *State) applyIncrementalChange(text string, change lsp.TextDocumentContentChangeEvent) string {
@@ -149,9 +302,11 @@ func (s *State) applyIncrementalChange(text string, change lsp.TextDocumentConte
 	endLine := change.Range.End.Line
 	endChar := change.Range.End.Character
 
-	if startLine >= len(lines) || endLine >= len(lines) {
+	if startLine < 0 || endLine < 0 || startLine >= len(lines) || endLine >= len(lines) {
 		return text
 	}
+	startChar = max(byteOffset(lines[startLine], startChar, s.positionEncoding), 0)
+	endChar = max(byteOffset(lines[endLine], endChar, s.positionEncoding), 0)
 
 	var result strings.Builder
 
@@ -192,21 +347,45 @@ func (s *State) applyIncrementalChange(text string, change lsp.TextDocumentConte
 	return result.String()
 }
 
+// CloseDocument forgets a document the client closed. Its text is read from
+// disk again when needed.
+func (s *State) CloseDocument(uri string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.Documents, uri)
+}
+
 func (s *State) SaveDocument(uri string) {
-	s.refreshDbtContext(s.LspClientRootPath)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.refreshProject(uri)
 }
 
-func (s *State) Hover(id int, uri string, position lsp.Position) lsp.HoverResponse {
+func (s *State) Hover(id lsp.RequestID, uri string, position lsp.Position) lsp.HoverResponse {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	s = s.project(uri)
+
 	response := lsp.HoverResponse{
 		Response: lsp.Response{
 			RPC: "2.0",
-			ID:  &id,
+			ID:  id,
 		},
 		Result: lsp.HoverResult{
 			Contents: "",
 		},
 	}
 
+	doc := s.Documents[uri]
+	if doc.Yaml != nil {
+		if contents, ok := s.yamlHover(doc, position); ok {
+			response.Result.Contents = contents
+			return response
+		}
+	}
+
 	cursorTokenLL, err := s.Documents[uri].Tokens.FindTokenAtCursor(position.Line, position.Character)
 	if err != nil {
 		return response
@@ -214,11 +393,11 @@ func (s *State) Hover(id int, uri string, position lsp.Position) lsp.HoverRespon
 
 	cursorToken := cursorTokenLL.Token
 
-	dialectFunctions := s.DbtContext.Dialect.FunctionDocs()
-
 	switch cursorToken.Type {
 	case parser.REF:
-		response.Result.Contents = s.DbtContext.ModelDetailMap[cursorToken.Literal].Description
+		key, _ := referenceKeyFromToken(cursorTokenLL, s.DbtContext.ProjectYaml.ProjectName.Value)
+		model, _ := s.resolveModel(key)
+		response.Result.Contents = withColumnTable(modelHoverDescription(model), model.Columns)
 	case parser.SOURCE:
 		response.Result.Contents = s.DbtContext.SourceDetailMap[cursorToken.Literal].Description
 	case parser.SOURCE_TABLE:
@@ -227,12 +406,15 @@ func (s *State) Hover(id int, uri string, position lsp.Position) lsp.HoverRespon
 			source := s.DbtContext.SourceDetailMap[tokenLiteral]
 			sourceTable := s.DbtContext.SourceDetailMap[tokenLiteral].Tables[cursorToken.Literal]
 
-			response.Result.Contents = fmt.Sprintf(
-				"Source: %s\n%s\n\nTable: %s\n%s",
-				source.Name,
-				source.Description,
-				sourceTable.Name,
-				sourceTable.Description,
+			response.Result.Contents = withColumnTable(
+				fmt.Sprintf(
+					"Source: %s\n%s\n\nTable: %s\n%s",
+					source.Name,
+					source.Description,
+					sourceTable.Name,
+					sourceTable.Description,
+				),
+				sourceTable.Columns,
 			)
 		}
 	case parser.VAR:
@@ -241,6 +423,10 @@ func (s *State) Hover(id int, uri string, position lsp.Position) lsp.HoverRespon
 			cursorToken.Literal,
 			s.DbtContext.VariableDetailMap[cursorToken.Literal].Value,
 		)
+	case parser.METRIC:
+		if metric, ok := s.DbtContext.SemanticDetailMap[MetricKind][cursorToken.Literal]; ok {
+			response.Result.Contents = semanticNodeMarkdown(metric)
+		}
 	case parser.MACRO:
 		packageName := Package(s.DbtContext.ProjectYaml.ProjectName.Value)
 		match, tokenLiteral := cursorTokenLL.TokenLookbackMatch(parser.PACKAGE, 2)
@@ -249,17 +435,27 @@ func (s *State) Hover(id int, uri string, position lsp.Position) lsp.HoverRespon
 		}
 		response.Result.Contents = s.DbtContext.MacroDetailMap[packageName][cursorToken.Literal].Description
 	default:
-		response.Result.Contents = dialectFunctions[cursorToken.Literal]
+		if doc.Yaml != nil {
+			break
+		}
+		response.Result.Contents = s.DbtContext.Dialect.FunctionMarkdown(cursorToken.Literal)
+		if response.Result.Contents == "" {
+			response.Result.Contents = s.columnLineageMarkdown(uri, cursorToken)
+		}
 	}
 
 	return response
 }
 
-func (s *State) Definition(id int, uri string, position lsp.Position) lsp.DefinitionResponse {
+func (s *State) Definition(id lsp.RequestID, uri string, position lsp.Position) lsp.DefinitionResponse {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	s = s.project(uri)
+
 	response := lsp.DefinitionResponse{
 		Response: lsp.Response{
 			RPC: "2.0",
-			ID:  &id,
+			ID:  id,
 		},
 		Result: lsp.Location{
 			URI: uri,
@@ -276,6 +472,13 @@ func (s *State) Definition(id int, uri string, position lsp.Position) lsp.Defini
 		},
 	}
 
+	if doc := s.Documents[uri]; doc.Yaml != nil {
+		if location, ok := s.yamlDefinition(doc, position); ok {
+			response.Result = location
+			return response
+		}
+	}
+
 	cursorTokenLL, err := s.Documents[uri].Tokens.FindTokenAtCursor(position.Line, position.Character)
 	if err != nil {
 		return response
@@ -283,79 +486,40 @@ func (s *State) Definition(id int, uri string, position lsp.Position) lsp.Defini
 
 	cursorToken := cursorTokenLL.Token
 
-	switch cursorToken.Type {
-	case parser.REF:
-		model := s.DbtContext.ModelDetailMap[cursorToken.Literal]
-		if model != (ModelDetails{}) {
-			response.Result.URI = "file://" + model.URI
-			response.Result.Range = lsp.Range{
-				Start: lsp.Position{
-					Line:      0,
-					Character: 0,
-				},
-				End: lsp.Position{
-					Line:      0,
-					Character: 0,
-				},
-			}
-		}
-	case parser.SOURCE:
-		source := s.DbtContext.SourceDetailMap[cursorToken.Literal]
-		if source.Name != "" {
-			response.Result.URI = "file://" + source.URI
-			response.Result.Range = source.Range
+	key, ok := referenceKeyFromToken(cursorTokenLL, s.DbtContext.ProjectYaml.ProjectName.Value)
+	if ok {
+		if location, found := s.declarationLocation(key); found {
+			response.Result = location
 		}
-	case parser.SOURCE_TABLE:
-		match, tokenLiteral := cursorTokenLL.TokenLookbackMatch(parser.SOURCE, 4)
-		if match {
-			source := s.DbtContext.SourceDetailMap[tokenLiteral].Tables[cursorToken.Literal]
-			if source.Name != "" {
-				response.Result.URI = "file://" + source.URI
-				response.Result.Range = source.Range
-			}
-		}
-	case parser.VAR:
-		variable := s.DbtContext.VariableDetailMap[cursorToken.Literal]
-		if variable != (Variable{}) {
-			response.Result.URI = "file://" + variable.URI
-			response.Result.Range = variable.Range
-		}
-	case parser.MACRO:
-		packageName := Package(s.DbtContext.ProjectYaml.ProjectName.Value)
-		match, tokenLiteral := cursorTokenLL.TokenLookbackMatch(parser.PACKAGE, 2)
-		if match {
-			packageName = Package(tokenLiteral)
-		}
-		macro := s.DbtContext.MacroDetailMap[packageName][cursorToken.Literal]
-		if macro != (Macro{}) {
-			response.Result.URI = "file://" + macro.URI
-			response.Result.Range = macro.Range
-		}
-	default:
-		response.Result.URI = uri
-		defToken := s.Documents[uri].DefTokens[cursorToken.Literal]
-		if defToken != (parser.Token{}) {
-			response.Result.Range = lsp.Range{
-				Start: lsp.Position{
-					Line:      defToken.Line,
-					Character: defToken.Column,
-				},
-				End: lsp.Position{
-					Line:      defToken.Line,
-					Character: defToken.Column,
-				},
-			}
+		return response
+	}
+
+	defToken := s.Documents[uri].DefTokens[cursorToken.Literal]
+	if defToken != (parser.Token{}) {
+		response.Result.Range = lsp.Range{
+			Start: lsp.Position{
+				Line:      defToken.Line,
+				Character: defToken.Column,
+			},
+			End: lsp.Position{
+				Line:      defToken.Line,
+				Character: defToken.Column,
+			},
 		}
 	}
 
 	return response
 }
 
-func (s *State) GoToSchema(id int, uri string, position lsp.Position) lsp.ExecuteCommandResponse {
+func (s *State) GoToSchema(id lsp.RequestID, uri string, position lsp.Position) lsp.ExecuteCommandResponse {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	s = s.project(uri)
+
 	response := lsp.ExecuteCommandResponse{
 		Response: lsp.Response{
 			RPC: "2.0",
-			ID:  &id,
+			ID:  id,
 		},
 		Result: nil,
 	}
@@ -370,7 +534,8 @@ func (s *State) GoToSchema(id int, uri string, position lsp.Position) lsp.Execut
 
 			if cursorToken.Type == parser.REF {
 				// Navigate to schema for the referenced model
-				model, modelExists := s.DbtContext.ModelDetailMap[cursorToken.Literal]
+				key, _ := referenceKeyFromToken(cursorTokenLL, s.DbtContext.ProjectYaml.ProjectName.Value)
+				model, modelExists := s.resolveModel(key)
 				if modelExists && model.SchemaURI != "" {
 					response.Result = lsp.Location{
 						URI:   "file://" + model.SchemaURI,
@@ -412,27 +577,65 @@ func getModelNameFromURI(uri string) string {
 	return ""
 }
 
-func (s *State) TextDocumentCompletion(id int, uri string, position lsp.Position) lsp.CompletionResponse {
+func (s *State) TextDocumentCompletion(id lsp.RequestID, uri string, position lsp.Position) lsp.CompletionResponse {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	s = s.project(uri)
+
 	items := []lsp.CompletionItem{}
+	response := lsp.CompletionResponse{
+		Response: lsp.Response{
+			RPC: "2.0",
+			ID:  id,
+		},
+		Result: items,
+	}
 
-	fileContents := s.Documents[uri].Text
-	lines := strings.Split(fileContents, "\n")
+	doc, ok := s.Documents[uri]
+	lines := strings.Split(doc.Text, "\n")
+	if !ok || position.Line < 0 || position.Line >= len(lines) {
+		return response
+	}
 	lineText := lines[position.Line]
 
-	cursorOffset := int(position.Character)
+	cursorOffset := min(max(position.Character, 0), len(lineText))
 	textBeforeCursor := lineText[:cursorOffset]
 	textAfterCursor := lineText[cursorOffset:]
 
 	refRegex := regexp.MustCompile(`\bref\(('|")[a-zA-z]*$`)
 	sourceRegex := regexp.MustCompile(`\bsource\(('|")[a-zA-z]*$`)
+	packageRefRegex := regexp.MustCompile(`\bref\(\s*['"](\w+)['"]\s*,\s*['"]\w*$`)
+	refVersionRegex := regexp.MustCompile(`\bref\(\s*['"](\w+)['"]\s*(?:,\s*['"](\w+)['"]\s*)?,\s*(?:v|version)\s*=\s*['"]?\w*$`)
 	varRegex := regexp.MustCompile(`\bvar\(('|")[a-zA-z]*$`)
+	metricRegex := regexp.MustCompile(`\bmetric\(('|")[a-zA-z_]*$`)
 	jinjaBlockRegex := regexp.MustCompile(`\{\{\s*`)
 
-	if refRegex.MatchString(textBeforeCursor) {
+	yamlItems, inYaml := []lsp.CompletionItem(nil), false
+	if doc.Yaml != nil {
+		yamlItems, inYaml = s.yamlCompletionItems(doc, position)
+	}
+
+	if inYaml {
+		items = yamlItems
+	} else if columnItems, ok := s.getColumnCompletionItems(uri, textBeforeCursor); ok {
+		items = columnItems
+	} else if refRegex.MatchString(textBeforeCursor) {
 		items = getRefCompletionItems(
 			s.DbtContext.ModelDetailMap,
 			getSuffix(lineText, textAfterCursor, "ref"),
 		)
+	} else if m := packageRefRegex.FindStringSubmatch(textBeforeCursor); m != nil {
+		items = getRefCompletionItems(
+			s.DbtContext.PackageModelMap[Package(m[1])],
+			getSuffix(lineText, textAfterCursor, "ref"),
+		)
+	} else if m := refVersionRegex.FindStringSubmatch(textBeforeCursor); m != nil {
+		key := ReferenceKey{Type: parser.REF, Name: m[1]}
+		if m[2] != "" {
+			key = ReferenceKey{Type: parser.REF, Package: m[1], Name: m[2]}
+		}
+		model, _ := s.resolveModel(key)
+		items = getVersionCompletionItems(model)
 	} else if sourceRegex.MatchString(textBeforeCursor) {
 		items = getSourceCompletionItems(
 			s.DbtContext.SourceDetailMap,
@@ -444,19 +647,14 @@ func (s *State) TextDocumentCompletion(id int, uri string, position lsp.Position
 			s.DbtContext.VariableDetailMap,
 			getSuffix(lineText, textAfterCursor, "var"),
 		)
+	} else if metricRegex.MatchString(textBeforeCursor) {
+		items = s.metricItems(getSuffix(lineText, textAfterCursor, "metric"))
 	} else if jinjaBlockRegex.MatchString(textBeforeCursor) {
 		items = getMacroCompletionItems(s.DbtContext.MacroDetailMap, s.DbtContext.ProjectYaml)
-	} else {
+	} else if doc.Yaml == nil {
 		items = s.DbtContext.Dialect.FunctionCompletionItems()
 	}
 
-	response := lsp.CompletionResponse{
-		Response: lsp.Response{
-			RPC: "2.0",
-			ID:  &id,
-		},
-		Result: items,
-	}
-
+	response.Result = items
 	return response
 }
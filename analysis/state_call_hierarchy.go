@@ -0,0 +1,279 @@
+package analysis
+
+import (
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/j-clemons/dbt-language-server/analysis/parser"
+	"github.com/j-clemons/dbt-language-server/lsp"
+	"github.com/j-clemons/dbt-language-server/lsp/symbolKind"
+)
+
+// lineageItemData is stored on each CallHierarchyItem so follow up
+// incoming/outgoing requests know which node of the DAG they refer to.
+type lineageItemData struct {
+	Type   string `json:"type"`
+	Name   string `json:"name"`
+	Source string `json:"source,omitempty"`
+	Path   string `json:"path,omitempty"`
+	// Package and Version are the arguments of a ref to a model.
+	Package string `json:"package,omitempty"`
+	Version string `json:"version,omitempty"`
+}
+
+func newCallHierarchyItem(name string, kind int, detail string, uri string, rng lsp.Range, data lineageItemData) lsp.CallHierarchyItem {
+	raw, _ := json.Marshal(data)
+	return lsp.CallHierarchyItem{
+		Name:           name,
+		Kind:           kind,
+		Detail:         detail,
+		URI:            "file://" + uri,
+		Range:          rng,
+		SelectionRange: rng,
+		Data:           raw,
+	}
+}
+
+func (s *State) modelItem(name string) (lsp.CallHierarchyItem, bool) {
+	return s.refItem(ReferenceKey{Type: parser.REF, Name: name})
+}
+
+// refItem returns the model a ref resolves to, which may be a package model
+// or a version of a versioned model.
+func (s *State) refItem(key ReferenceKey) (lsp.CallHierarchyItem, bool) {
+	model, ok := s.resolveModel(key)
+	if !ok {
+		return lsp.CallHierarchyItem{}, false
+	}
+	kind := symbolKind.Class
+	if filepath.Ext(model.URI) == ".csv" {
+		kind = symbolKind.File
+	}
+	data := lineageItemData{Type: "model", Name: key.Name, Package: key.Package, Version: key.Version}
+	return newCallHierarchyItem(key.Name, kind, model.ProjectName, model.URI, lsp.Range{}, data), true
+}
+
+func (s *State) sourceTableItem(sourceName string, tableName string) (lsp.CallHierarchyItem, bool) {
+	source, ok := s.DbtContext.SourceDetailMap[sourceName]
+	if !ok {
+		return lsp.CallHierarchyItem{}, false
+	}
+	table, ok := source.Tables[tableName]
+	if !ok {
+		return lsp.CallHierarchyItem{}, false
+	}
+	return newCallHierarchyItem(
+		sourceName+"."+tableName,
+		symbolKind.Struct,
+		"source",
+		table.URI,
+		table.Range,
+		lineageItemData{Type: "source", Source: sourceName, Name: tableName},
+	), true
+}
+
+// fileItem returns the model defined by path, falling back to a plain file
+// item for snapshots, tests, macros and anything else that refs a model.
+func (s *State) fileItem(path string) lsp.CallHierarchyItem {
+	for name, model := range s.DbtContext.ModelDetailMap {
+		if model.URI == path {
+			item, _ := s.modelItem(name)
+			return item
+		}
+	}
+	return newCallHierarchyItem(filepath.Base(path), symbolKind.File, "", path, lsp.Range{}, lineageItemData{Type: "file", Path: path})
+}
+
+// fileReferences returns the ref and source references made by the file at
+// path, preferring the in-memory text when the file is open.
+func (s *State) fileReferences(path string) []Reference {
+	references := []Reference{}
+
+	if doc, open := s.Documents["file://"+path]; open {
+		if doc.Tokens == nil {
+			return references
+		}
+		for _, r := range getReferencesFromTokens(doc.Tokens.Tokens(), path, s.DbtContext.ProjectYaml.ProjectName.Value) {
+			if r.Key.Type == parser.REF || r.Key.Type == parser.SOURCE_TABLE {
+				references = append(references, r)
+			}
+		}
+		return references
+	}
+
+	for key, refs := range s.DbtContext.ReferenceIndex {
+		if key.Type != parser.REF && key.Type != parser.SOURCE_TABLE {
+			continue
+		}
+		for _, r := range refs {
+			if r.URI == path {
+				references = append(references, r)
+			}
+		}
+	}
+
+	sort.Slice(references, func(i, j int) bool {
+		if references[i].Range.Start.Line != references[j].Range.Start.Line {
+			return references[i].Range.Start.Line < references[j].Range.Start.Line
+		}
+		return references[i].Range.Start.Character < references[j].Range.Start.Character
+	})
+
+	return references
+}
+
+func decodeLineageItem(item lsp.CallHierarchyItem) (lineageItemData, bool) {
+	var data lineageItemData
+	if err := json.Unmarshal(item.Data, &data); err != nil {
+		return lineageItemData{}, false
+	}
+	return data, true
+}
+
+func (s *State) PrepareCallHierarchy(id lsp.RequestID, uri string, position lsp.Position) lsp.CallHierarchyPrepareResponse {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	s = s.project(uri)
+
+	response := lsp.CallHierarchyPrepareResponse{
+		Response: lsp.Response{
+			RPC: "2.0",
+			ID:  id,
+		},
+		Result: nil,
+	}
+
+	doc, exists := s.Documents[uri]
+	if !exists || doc.Tokens == nil {
+		return response
+	}
+
+	if cursorTokenLL, err := doc.Tokens.FindTokenAtCursor(position.Line, position.Character); err == nil {
+		key, ok := referenceKeyFromToken(cursorTokenLL, s.DbtContext.ProjectYaml.ProjectName.Value)
+		if ok {
+			switch key.Type {
+			case parser.REF:
+				if item, found := s.refItem(key); found {
+					response.Result = []lsp.CallHierarchyItem{item}
+				}
+				return response
+			case parser.SOURCE_TABLE:
+				if item, found := s.sourceTableItem(key.Package, key.Name); found {
+					response.Result = []lsp.CallHierarchyItem{item}
+				}
+				return response
+			}
+		}
+	}
+
+	response.Result = []lsp.CallHierarchyItem{s.fileItem(strings.TrimPrefix(uri, "file://"))}
+	return response
+}
+
+func (s *State) IncomingCalls(ctx context.Context, id lsp.RequestID, item lsp.CallHierarchyItem) lsp.CallHierarchyIncomingCallsResponse {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	s = s.project(item.URI)
+
+	response := lsp.CallHierarchyIncomingCallsResponse{
+		Response: lsp.Response{
+			RPC: "2.0",
+			ID:  id,
+		},
+		Result: []lsp.CallHierarchyIncomingCall{},
+	}
+
+	data, ok := decodeLineageItem(item)
+	if !ok {
+		return response
+	}
+
+	var key ReferenceKey
+	switch data.Type {
+	case "model":
+		key = ReferenceKey{Type: parser.REF, Package: data.Package, Name: data.Name, Version: data.Version}
+	case "source":
+		key = ReferenceKey{Type: parser.SOURCE_TABLE, Package: data.Source, Name: data.Name}
+	default:
+		return response
+	}
+
+	// findReferences sorts by URI so calls from the same file are adjacent
+	for _, r := range s.findReferences(ctx, key) {
+		last := len(response.Result) - 1
+		if last >= 0 && response.Result[last].From.URI == "file://"+r.URI {
+			response.Result[last].FromRanges = append(response.Result[last].FromRanges, r.Range)
+			continue
+		}
+		response.Result = append(response.Result, lsp.CallHierarchyIncomingCall{
+			From:       s.fileItem(r.URI),
+			FromRanges: []lsp.Range{r.Range},
+		})
+	}
+
+	return response
+}
+
+func (s *State) OutgoingCalls(id lsp.RequestID, item lsp.CallHierarchyItem) lsp.CallHierarchyOutgoingCallsResponse {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	s = s.project(item.URI)
+
+	response := lsp.CallHierarchyOutgoingCallsResponse{
+		Response: lsp.Response{
+			RPC: "2.0",
+			ID:  id,
+		},
+		Result: []lsp.CallHierarchyOutgoingCall{},
+	}
+
+	data, ok := decodeLineageItem(item)
+	if !ok {
+		return response
+	}
+
+	var path string
+	switch data.Type {
+	case "model":
+		model, ok := s.resolveModel(ReferenceKey{Type: parser.REF, Package: data.Package, Name: data.Name, Version: data.Version})
+		if !ok {
+			return response
+		}
+		path = model.URI
+	case "file":
+		path = data.Path
+	default:
+		// sources are the roots of the DAG
+		return response
+	}
+
+	callIndex := make(map[ReferenceKey]int)
+	for _, r := range s.fileReferences(path) {
+		if idx, seen := callIndex[r.Key]; seen {
+			response.Result[idx].FromRanges = append(response.Result[idx].FromRanges, r.Range)
+			continue
+		}
+
+		var to lsp.CallHierarchyItem
+		var found bool
+		if r.Key.Type == parser.REF {
+			to, found = s.refItem(r.Key)
+		} else {
+			to, found = s.sourceTableItem(r.Key.Package, r.Key.Name)
+		}
+		if !found {
+			continue
+		}
+
+		callIndex[r.Key] = len(response.Result)
+		response.Result = append(response.Result, lsp.CallHierarchyOutgoingCall{
+			To:         to,
+			FromRanges: []lsp.Range{r.Range},
+		})
+	}
+
+	return response
+}
@@ -0,0 +1,119 @@
+package analysis
+
+import (
+	"context"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/j-clemons/dbt-language-server/lsp"
+	"github.com/j-clemons/dbt-language-server/testutils"
+)
+
+func TestPrepareCallHierarchy(t *testing.T) {
+	testdataRoot, err := testutils.GetTestdataPath("jaffle_shop_duckdb")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	state := NewState()
+	state.refreshDbtContext(testdataRoot)
+
+	uri := "file://" + filepath.Join(testdataRoot, "models/orders.sql")
+	state.parseDocument(uri, "select *\nfrom {{ ref('stg_orders') }}")
+
+	tests := []struct {
+		name     string
+		position lsp.Position
+		expected string
+	}{
+		{name: "cursor on ref", position: lsp.Position{Line: 1, Character: 16}, expected: "stg_orders"},
+		{name: "cursor elsewhere", position: lsp.Position{Line: 0, Character: 2}, expected: "orders"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			response := state.PrepareCallHierarchy(1, uri, tt.position)
+			if len(response.Result) != 1 || response.Result[0].Name != tt.expected {
+				t.Fatalf("expected item %s, got %v", tt.expected, response.Result)
+			}
+		})
+	}
+}
+
+func TestIncomingCalls(t *testing.T) {
+	testdataRoot, err := testutils.GetTestdataPath("jaffle_shop_duckdb")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	state := NewState()
+	state.refreshDbtContext(testdataRoot)
+
+	item, _ := state.modelItem("stg_orders")
+	response := state.IncomingCalls(context.Background(), 1, item)
+
+	customers, _ := state.modelItem("customers")
+	orders, _ := state.modelItem("orders")
+	expected := []lsp.CallHierarchyIncomingCall{
+		{
+			From: customers,
+			FromRanges: []lsp.Range{
+				{Start: lsp.Position{Line: 8, Character: 26}, End: lsp.Position{Line: 8, Character: 36}},
+			},
+		},
+		{
+			From: orders,
+			FromRanges: []lsp.Range{
+				{Start: lsp.Position{Line: 4, Character: 26}, End: lsp.Position{Line: 4, Character: 36}},
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(response.Result, expected) {
+		t.Fatalf("expected %v,\n\ngot %v", expected, response.Result)
+	}
+}
+
+func TestOutgoingCalls(t *testing.T) {
+	testdataRoot, err := testutils.GetTestdataPath("jaffle_shop_duckdb")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	state := NewState()
+	state.refreshDbtContext(testdataRoot)
+
+	uri := "file:///scratch/models/new_model.sql"
+	state.parseDocument(uri, "select *\nfrom {{ ref('stg_orders') }}\njoin {{ ref('stg_orders') }}\njoin {{ source('jaffle_shop', 'orders') }}\njoin {{ ref('missing') }}")
+
+	item := state.PrepareCallHierarchy(1, uri, lsp.Position{Line: 0, Character: 0}).Result[0]
+	response := state.OutgoingCalls(1, item)
+
+	stgOrders, _ := state.modelItem("stg_orders")
+	sourceOrders, _ := state.sourceTableItem("jaffle_shop", "orders")
+	expected := []lsp.CallHierarchyOutgoingCall{
+		{
+			To: stgOrders,
+			FromRanges: []lsp.Range{
+				{Start: lsp.Position{Line: 1, Character: 13}, End: lsp.Position{Line: 1, Character: 23}},
+				{Start: lsp.Position{Line: 2, Character: 13}, End: lsp.Position{Line: 2, Character: 23}},
+			},
+		},
+		{
+			To: sourceOrders,
+			FromRanges: []lsp.Range{
+				{Start: lsp.Position{Line: 3, Character: 31}, End: lsp.Position{Line: 3, Character: 37}},
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(response.Result, expected) {
+		t.Fatalf("expected %v,\n\ngot %v", expected, response.Result)
+	}
+
+	response = state.OutgoingCalls(1, sourceOrders)
+	if len(response.Result) != 0 {
+		t.Fatalf("expected no outgoing calls from a source, got %v", response.Result)
+	}
+}
@@ -0,0 +1,348 @@
+package analysis
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/j-clemons/dbt-language-server/lsp"
+	"github.com/j-clemons/dbt-language-server/lsp/completionKind"
+	diagnosticseverity "github.com/j-clemons/dbt-language-server/lsp/diagnosticSeverity"
+	"gopkg.in/yaml.v3"
+)
+
+func isProjectYamlURI(uri string) bool {
+	return filepath.Base(uri) == "dbt_project.yml"
+}
+
+// projectKey is where a list of keys leads in the dbt_project.yml schema.
+type projectKey struct {
+	// key is the schema of the last key, nil when it isn't known.
+	key *schemaKey
+	// tree is set when the last key is a package or folder in a config
+	// tree, folders holding the names below the tree's key.
+	tree    *schemaKey
+	folders []string
+}
+
+func lookupProjectKey(keys []string) projectKey {
+	cur := projectKey{key: projectSchema}
+	for _, name := range keys {
+		if cur.tree != nil {
+			if config, ok := cur.tree.Configs[strings.TrimPrefix(name, "+")]; ok {
+				cur = projectKey{key: config}
+			} else {
+				cur.folders = append(cur.folders, name)
+			}
+			continue
+		}
+		if cur.key == nil || cur.key.Keys == nil {
+			return projectKey{}
+		}
+		next, ok := cur.key.Keys[name]
+		if !ok {
+			return projectKey{}
+		}
+		cur = projectKey{key: next}
+		if next.Configs != nil {
+			cur.tree = next
+		}
+	}
+	return cur
+}
+
+// projectPaths returns the directories a project lists under a paths key.
+func projectPaths(projYaml DbtProjectYaml, key string) []string {
+	paths := []string{}
+	switch key {
+	case "model-paths":
+		paths = projYaml.ModelPaths.Value
+	case "seed-paths":
+		paths = projYaml.SeedPaths.Value
+	}
+	if len(paths) == 0 {
+		paths = []string{strings.TrimSuffix(key, "-paths") + "s"}
+	}
+	return paths
+}
+
+// configTreeFolders lists the names that can nest under folders in a
+// config tree: projects at the top, then the directories of that project's
+// resource paths.
+func (s *State) configTreeFolders(tree *schemaKey, folders []string) []string {
+	projects := map[string]ProjectDetails{
+		s.DbtContext.ProjectYaml.ProjectName.Value: {
+			RootPath:       s.DbtContext.ProjectRoot,
+			DbtProjectYaml: s.DbtContext.ProjectYaml,
+		},
+	}
+	for _, p := range getPackageModelDetails(s.DbtContext.ProjectRoot, s.DbtContext.ProjectYaml) {
+		projects[p.DbtProjectYaml.ProjectName.Value] = p
+	}
+
+	names := []string{}
+	if len(folders) == 0 {
+		for name := range projects {
+			if name != "" {
+				names = append(names, name)
+			}
+		}
+		sort.Strings(names)
+		return names
+	}
+
+	project, ok := projects[folders[0]]
+	if !ok || tree.Paths == "" {
+		return names
+	}
+	seen := map[string]bool{}
+	for _, path := range projectPaths(project.DbtProjectYaml, tree.Paths) {
+		dir := filepath.Join(append([]string{project.RootPath, path}, folders[1:]...)...)
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+		for _, entry := range entries {
+			if entry.IsDir() && !seen[entry.Name()] {
+				seen[entry.Name()] = true
+				names = append(names, entry.Name())
+			}
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func schemaKeyItems(keys map[string]*schemaKey, prefix string) []lsp.CompletionItem {
+	items := []lsp.CompletionItem{}
+	for name, k := range keys {
+		items = append(items, lsp.CompletionItem{
+			Label:         prefix + name,
+			Detail:        k.Type.String(),
+			Documentation: k.Description,
+			Kind:          completionKind.Property,
+			InsertText:    prefix + name,
+			SortText:      "0" + name,
+		})
+	}
+	return items
+}
+
+// projectCompletionItems completes keys and values of dbt_project.yml.
+func (s *State) projectCompletionItems(doc Document, position lsp.Position) ([]lsp.CompletionItem, bool) {
+	if position.Line < 0 || position.Line >= len(doc.Yaml.Lines) {
+		return nil, false
+	}
+	line := doc.Yaml.Lines[position.Line]
+	if position.Character < 0 || position.Character > len(line) {
+		return nil, false
+	}
+	before := line[:position.Character]
+
+	if strings.Contains(before, ":") {
+		ctx, ok := doc.Yaml.contextAt(position.Line)
+		if !ok || !onYamlValue(position, ctx) {
+			return nil, false
+		}
+		return projectValueItems(lookupProjectKey(append(ctx.Keys, ctx.Key)).key)
+	}
+
+	// the key being typed has no colon yet, so the context is read from a
+	// copy of the document with the line completed
+	patched := &yamlDocument{Lines: append([]string{}, doc.Yaml.Lines...)}
+	patched.Lines[position.Line] = before + "_:"
+	ctx, ok := patched.contextAt(position.Line)
+	if !ok {
+		return nil, false
+	}
+
+	target := lookupProjectKey(ctx.Keys)
+	switch {
+	case target.tree != nil:
+		items := schemaKeyItems(target.tree.Configs, "+")
+		for _, name := range s.configTreeFolders(target.tree, target.folders) {
+			items = append(items, lsp.CompletionItem{
+				Label:      name,
+				Detail:     "Folder",
+				Kind:       completionKind.Folder,
+				InsertText: name,
+				SortText:   "1" + name,
+			})
+		}
+		return items, true
+	case target.key != nil && target.key.Keys != nil:
+		return schemaKeyItems(target.key.Keys, ""), true
+	}
+	return nil, false
+}
+
+func projectValueItems(k *schemaKey) ([]lsp.CompletionItem, bool) {
+	if k == nil {
+		return nil, false
+	}
+	values := k.Values
+	if len(values) == 0 && k.Type == yamlBool {
+		values = []string{"true", "false"}
+	}
+	if len(values) == 0 {
+		return nil, false
+	}
+	items := []lsp.CompletionItem{}
+	for i, value := range values {
+		items = append(items, lsp.CompletionItem{
+			Label:      value,
+			Kind:       completionKind.Value,
+			InsertText: value,
+			SortText:   fmt.Sprintf("%02d", i),
+		})
+	}
+	return items, true
+}
+
+// projectHover documents the dbt_project.yml key under the cursor.
+func (s *State) projectHover(doc Document, position lsp.Position) (string, bool) {
+	ctx, ok := doc.Yaml.contextAt(position.Line)
+	if !ok || ctx.Key == "" || onYamlValue(position, ctx) {
+		return "", false
+	}
+	target := lookupProjectKey(append(ctx.Keys, ctx.Key))
+	if target.key == nil || len(target.folders) > 0 {
+		return "", false
+	}
+
+	contents := fmt.Sprintf("**%s** (%s)\n\n%s", ctx.Key, target.key.Type, target.key.Description)
+	if len(target.key.Values) > 0 {
+		contents += "\n\nValues: `" + strings.Join(target.key.Values, "`, `") + "`"
+	}
+	return strings.TrimSpace(contents), true
+}
+
+// projectValidator reports dbt_project.yml keys missing from the schema
+// and values of the wrong type.
+type projectValidator struct {
+	diagnostics []lsp.Diagnostic
+}
+
+func nodeRange(node *yaml.Node) lsp.Range {
+	start := lsp.Position{Line: node.Line - 1, Character: node.Column - 1}
+	return lsp.Range{
+		Start: start,
+		End:   lsp.Position{Line: start.Line, Character: start.Character + len(node.Value)},
+	}
+}
+
+func (v *projectValidator) report(node *yaml.Node, severity int, code string, format string, args ...any) {
+	v.diagnostics = append(v.diagnostics, lsp.Diagnostic{
+		Range:    nodeRange(node),
+		Message:  fmt.Sprintf(format, args...),
+		Severity: severity,
+		Code:     code,
+		Source:   diagnosticSource,
+	})
+}
+
+// nodeType is the kind of a YAML value, zero for null.
+func nodeType(node *yaml.Node) yamlType {
+	if node.Kind == yaml.AliasNode && node.Alias != nil {
+		return nodeType(node.Alias)
+	}
+	switch node.Kind {
+	case yaml.MappingNode:
+		return yamlMapping
+	case yaml.SequenceNode:
+		return yamlList
+	case yaml.ScalarNode:
+		switch node.ShortTag() {
+		case "!!null":
+			return 0
+		case "!!bool":
+			return yamlBool
+		case "!!int", "!!float":
+			return yamlNumber
+		}
+	}
+	return yamlString
+}
+
+func (v *projectValidator) mapping(node *yaml.Node, schema *schemaKey, parent string) {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		keyNode, valueNode := node.Content[i], node.Content[i+1]
+		k, ok := schema.Keys[keyNode.Value]
+		if !ok {
+			if parent == "" {
+				v.report(keyNode, diagnosticseverity.Warning, "unknown-key", "Unknown key '%s' in dbt_project.yml", keyNode.Value)
+			} else {
+				v.report(keyNode, diagnosticseverity.Warning, "unknown-key", "Unknown key '%s' under '%s'", keyNode.Value, parent)
+			}
+			continue
+		}
+		v.value(keyNode, valueNode, k)
+	}
+}
+
+func (v *projectValidator) value(keyNode *yaml.Node, node *yaml.Node, k *schemaKey) {
+	t := nodeType(node)
+	if t == 0 {
+		return
+	}
+	// jinja is rendered before the value is read, so its type is unknown
+	if t == yamlString && strings.Contains(node.Value, "{{") {
+		return
+	}
+	if t&k.Type == 0 {
+		v.report(keyNode, diagnosticseverity.Error, "invalid-type", "'%s' should be %s", keyNode.Value, k.Type)
+		return
+	}
+
+	switch {
+	case t == yamlMapping && k.Configs != nil:
+		v.tree(node, k, keyNode.Value)
+	case t == yamlMapping && k.Keys != nil:
+		v.mapping(node, k, keyNode.Value)
+	case t == yamlList && k.Keys != nil:
+		for _, item := range node.Content {
+			if nodeType(item) == yamlMapping {
+				v.mapping(item, k, keyNode.Value)
+			}
+		}
+	}
+}
+
+// tree checks a config tree, where keys are configs or package and folder
+// names holding nested trees.
+func (v *projectValidator) tree(node *yaml.Node, tree *schemaKey, resource string) {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		keyNode, valueNode := node.Content[i], node.Content[i+1]
+		if config, ok := tree.Configs[strings.TrimPrefix(keyNode.Value, "+")]; ok {
+			v.value(keyNode, valueNode, config)
+			continue
+		}
+		switch nodeType(valueNode) {
+		case yamlMapping:
+			if !strings.HasPrefix(keyNode.Value, "+") {
+				v.tree(valueNode, tree, resource)
+				continue
+			}
+		case 0:
+			if !strings.HasPrefix(keyNode.Value, "+") {
+				continue
+			}
+		}
+		v.report(keyNode, diagnosticseverity.Warning, "unknown-key", "Unknown config '%s' for %s", keyNode.Value, resource)
+	}
+}
+
+// projectDiagnostics validates dbt_project.yml against the schema.
+func (s *State) projectDiagnostics(doc Document) []lsp.Diagnostic {
+	var root yaml.Node
+	if err := yaml.Unmarshal([]byte(doc.Text), &root); err != nil || len(root.Content) == 0 {
+		return []lsp.Diagnostic{}
+	}
+	v := projectValidator{diagnostics: []lsp.Diagnostic{}}
+	if nodeType(root.Content[0]) == yamlMapping {
+		v.mapping(root.Content[0], projectSchema, "")
+	}
+	return v.diagnostics
+}
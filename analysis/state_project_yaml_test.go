@@ -0,0 +1,210 @@
+package analysis
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/j-clemons/dbt-language-server/lsp"
+	"github.com/j-clemons/dbt-language-server/lsp/completionKind"
+	diagnosticseverity "github.com/j-clemons/dbt-language-server/lsp/diagnosticSeverity"
+)
+
+// trailing whitespace is where the cursor sits while typing
+const projectTestYaml = "name: 'jaffle_shop'\n" +
+	"conf\n" +
+	"models:\n" +
+	"  jaffle_shop:\n" +
+	"    +materialized: \n" +
+	"    sta\n" +
+	"    staging:\n" +
+	"      \n" +
+	"dispatch:\n" +
+	"  - \n" +
+	"flags:\n" +
+	"  partial_parse: \n"
+
+func projectTestState(t *testing.T, text string) (*State, string) {
+	state, testdataRoot := yamlTestState(t)
+	uri := "file://" + filepath.Join(testdataRoot, "dbt_project.yml")
+	state.parseDocument(uri, text)
+	return state, uri
+}
+
+func TestProjectYamlCompletion(t *testing.T) {
+	state, uri := projectTestState(t, projectTestYaml)
+
+	tests := []struct {
+		name     string
+		position lsp.Position
+		// folders are the expected Folder items, keys a sample of the rest
+		folders []string
+		keys    []string
+	}{
+		{
+			name:     "top level keys",
+			position: lsp.Position{Line: 1, Character: 4},
+			folders:  []string{},
+			keys:     []string{"config-version", "model-paths", "on-run-start", "dispatch", "flags"},
+		},
+		{
+			name:     "projects",
+			position: lsp.Position{Line: 3, Character: 2},
+			folders:  []string{"jaffle_package", "jaffle_shop"},
+			keys:     []string{"+materialized", "+schema", "+tags"},
+		},
+		{
+			name:     "model folders",
+			position: lsp.Position{Line: 5, Character: 7},
+			folders:  []string{"staging"},
+			keys:     []string{"+materialized", "+docs", "+incremental_strategy"},
+		},
+		{
+			name:     "empty folder",
+			position: lsp.Position{Line: 7, Character: 6},
+			folders:  []string{},
+			keys:     []string{"+schema"},
+		},
+		{
+			name:     "dispatch entries",
+			position: lsp.Position{Line: 9, Character: 4},
+			folders:  []string{},
+			keys:     []string{"macro_namespace", "search_order"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			folders := []string{}
+			keys := []string{}
+			for _, item := range state.TextDocumentCompletion(1, uri, tt.position).Result {
+				if item.Kind == completionKind.Folder {
+					folders = append(folders, item.Label)
+				} else {
+					keys = append(keys, item.Label)
+				}
+			}
+			sort.Strings(folders)
+			if !reflect.DeepEqual(folders, tt.folders) {
+				t.Errorf("expected folders %v, got %v", tt.folders, folders)
+			}
+			for _, key := range tt.keys {
+				if !slices.Contains(keys, key) {
+					t.Errorf("expected %q in %v", key, keys)
+				}
+			}
+		})
+	}
+
+	values := []struct {
+		name     string
+		position lsp.Position
+		expected []string
+	}{
+		{
+			name:     "materializations",
+			position: lsp.Position{Line: 4, Character: 19},
+			expected: []string{"view", "table", "incremental", "ephemeral", "materialized_view"},
+		},
+		{
+			name:     "booleans",
+			position: lsp.Position{Line: 11, Character: 17},
+			expected: []string{"true", "false"},
+		},
+	}
+	for _, tt := range values {
+		t.Run(tt.name, func(t *testing.T) {
+			response := state.TextDocumentCompletion(1, uri, tt.position)
+			if actual := completionLabels(response.Result); !reflect.DeepEqual(actual, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestProjectYamlHover(t *testing.T) {
+	state, uri := projectTestState(t, projectTestYaml)
+
+	hover := state.Hover(1, uri, lsp.Position{Line: 4, Character: 8})
+	for _, want := range []string{"**+materialized** (a string)", "How the model is built", "`ephemeral`"} {
+		if !strings.Contains(hover.Result.Contents, want) {
+			t.Errorf("expected hover to contain %q, got %q", want, hover.Result.Contents)
+		}
+	}
+
+	hover = state.Hover(2, uri, lsp.Position{Line: 2, Character: 2})
+	if !strings.Contains(hover.Result.Contents, "Configs for models") {
+		t.Errorf("expected models hover, got %q", hover.Result.Contents)
+	}
+
+	// folders have no schema entry
+	if hover := state.Hover(3, uri, lsp.Position{Line: 6, Character: 6}); hover.Result.Contents != "" {
+		t.Errorf("expected no hover on a folder, got %q", hover.Result.Contents)
+	}
+}
+
+func TestProjectYamlDiagnostics(t *testing.T) {
+	state, uri := projectTestState(t, `name: 'jaffle_shop'
+config-version: 2
+model-paths: "models"
+on-run-start:
+  - "{{ log('start') }}"
+dispatch:
+  - macro_namespace: dbt_utils
+    search_orders: ["jaffle_shop"]
+flags:
+  partial_parse: true
+  fail_fast: "yes please"
+models:
+  jaffle_shop:
+    +materialized: table
+    +schema:
+    +materialised: view
+    staging:
+      materialized: 3
+      +enabled: "{{ target.name == 'prod' }}"
+      +docs:
+        colour: red
+seedz: {}
+`)
+
+	diagnostic := func(line, character, length, severity int, code, message string) lsp.Diagnostic {
+		return lsp.Diagnostic{
+			Range: lsp.Range{
+				Start: lsp.Position{Line: line, Character: character},
+				End:   lsp.Position{Line: line, Character: character + length},
+			},
+			Message:  message,
+			Severity: severity,
+			Code:     code,
+			Source:   diagnosticSource,
+		}
+	}
+	expected := []lsp.Diagnostic{
+		diagnostic(2, 0, 11, diagnosticseverity.Error, "invalid-type", "'model-paths' should be a list"),
+		diagnostic(7, 4, 13, diagnosticseverity.Warning, "unknown-key", "Unknown key 'search_orders' under 'dispatch'"),
+		diagnostic(10, 2, 9, diagnosticseverity.Error, "invalid-type", "'fail_fast' should be a boolean"),
+		diagnostic(15, 4, 13, diagnosticseverity.Warning, "unknown-key", "Unknown config '+materialised' for models"),
+		diagnostic(17, 6, 12, diagnosticseverity.Error, "invalid-type", "'materialized' should be a string"),
+		diagnostic(20, 8, 6, diagnosticseverity.Warning, "unknown-key", "Unknown key 'colour' under '+docs'"),
+		diagnostic(21, 0, 5, diagnosticseverity.Warning, "unknown-key", "Unknown key 'seedz' in dbt_project.yml"),
+	}
+	if actual := state.Diagnostics(uri); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected %v,\n\ngot %v", expected, actual)
+	}
+
+	// the test project's own dbt_project.yml is valid
+	testdataRoot := filepath.Dir(strings.TrimPrefix(uri, "file://"))
+	text, err := os.ReadFile(filepath.Join(testdataRoot, "dbt_project.yml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	state.parseDocument(uri, string(text))
+	if actual := state.Diagnostics(uri); len(actual) != 0 {
+		t.Errorf("expected no diagnostics, got %v", actual)
+	}
+}
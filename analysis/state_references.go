@@ -0,0 +1,195 @@
+package analysis
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/j-clemons/dbt-language-server/analysis/parser"
+	"github.com/j-clemons/dbt-language-server/lsp"
+)
+
+func (s *State) declarationLocation(key ReferenceKey) (lsp.Location, bool) {
+	switch key.Type {
+	case parser.REF:
+		model, _ := s.resolveModel(key)
+		if model.URI != "" {
+			return lsp.Location{
+				URI:   "file://" + model.URI,
+				Range: model.Range,
+			}, true
+		}
+	case parser.SOURCE:
+		source := s.DbtContext.SourceDetailMap[key.Name]
+		if source.Name != "" {
+			return lsp.Location{
+				URI:   "file://" + source.URI,
+				Range: source.Range,
+			}, true
+		}
+	case parser.SOURCE_TABLE:
+		sourceTable := s.DbtContext.SourceDetailMap[key.Package].Tables[key.Name]
+		if sourceTable.Name != "" {
+			return lsp.Location{
+				URI:   "file://" + sourceTable.URI,
+				Range: sourceTable.Range,
+			}, true
+		}
+	case parser.METRIC:
+		if metric, ok := s.DbtContext.SemanticDetailMap[MetricKind][key.Name]; ok {
+			return lsp.Location{
+				URI:   "file://" + metric.URI,
+				Range: metric.Range,
+			}, true
+		}
+	case parser.VAR:
+		variable := s.DbtContext.VariableDetailMap[key.Name]
+		if variable != (Variable{}) {
+			return lsp.Location{
+				URI:   "file://" + variable.URI,
+				Range: variable.Range,
+			}, true
+		}
+	case parser.MACRO:
+		macro := s.DbtContext.MacroDetailMap[Package(key.Package)][key.Name]
+		if macro.Name != "" {
+			return lsp.Location{
+				URI:   "file://" + macro.URI,
+				Range: macro.Range,
+			}, true
+		}
+	}
+
+	return lsp.Location{}, false
+}
+
+// referenceMatcher reports whether a reference points at the same resource
+// as key. Refs match when they resolve to the same model, so a model is
+// told apart from a package model of the same name and from its other
+// versions. Refs to a missing model match when their arguments do.
+func (s *State) referenceMatcher(key ReferenceKey) func(ReferenceKey) bool {
+	if key.Type != parser.REF {
+		return func(k ReferenceKey) bool { return k == key }
+	}
+	target, resolved := s.resolveModel(key)
+	return func(k ReferenceKey) bool {
+		if k.indexKey() != key.indexKey() {
+			return false
+		}
+		if !resolved {
+			return k == key
+		}
+		model, ok := s.resolveModel(k)
+		return ok && sameModel(model, target)
+	}
+}
+
+// findReferences returns every usage of key across the project and installed
+// packages. Open documents are read from their in-memory text so unsaved
+// edits are reflected, and those of other dbt projects are skipped. The
+// search stops early once ctx is cancelled.
+func (s *State) findReferences(ctx context.Context, key ReferenceKey) []Reference {
+	references := []Reference{}
+	matches := s.referenceMatcher(key)
+
+	for _, r := range s.DbtContext.ReferenceIndex[key.indexKey()] {
+		if _, open := s.Documents["file://"+r.URI]; open || !matches(r.Key) {
+			continue
+		}
+		references = append(references, r)
+	}
+
+	projectName := s.DbtContext.ProjectYaml.ProjectName.Value
+	for uri, doc := range s.Documents {
+		if ctx.Err() != nil {
+			return references
+		}
+		path := strings.TrimPrefix(uri, "file://")
+		if doc.Tokens == nil || !s.inProject(path) {
+			continue
+		}
+		for _, r := range getReferencesFromTokens(doc.Tokens.Tokens(), path, projectName) {
+			if matches(r.Key) {
+				references = append(references, r)
+			}
+		}
+	}
+
+	sort.Slice(references, func(i, j int) bool {
+		if references[i].URI != references[j].URI {
+			return references[i].URI < references[j].URI
+		}
+		if references[i].Range.Start.Line != references[j].Range.Start.Line {
+			return references[i].Range.Start.Line < references[j].Range.Start.Line
+		}
+		return references[i].Range.Start.Character < references[j].Range.Start.Character
+	})
+
+	return references
+}
+
+func (s *State) References(ctx context.Context, id lsp.RequestID, uri string, position lsp.Position, includeDeclaration bool) lsp.ReferencesResponse {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	s = s.project(uri)
+
+	response := lsp.ReferencesResponse{
+		Response: lsp.Response{
+			RPC: "2.0",
+			ID:  id,
+		},
+		Result: []lsp.Location{},
+	}
+
+	doc, exists := s.Documents[uri]
+	if !exists || doc.Tokens == nil {
+		return response
+	}
+
+	cursorTokenLL, err := doc.Tokens.FindTokenAtCursor(position.Line, position.Character)
+	if err != nil {
+		return response
+	}
+
+	key, ok := referenceKeyFromToken(cursorTokenLL, s.DbtContext.ProjectYaml.ProjectName.Value)
+	if !ok {
+		return response
+	}
+
+	if includeDeclaration {
+		if location, found := s.declarationLocation(key); found {
+			response.Result = append(response.Result, location)
+		}
+	}
+
+	if key.Type == parser.REF {
+		model, _ := s.resolveModel(key)
+		if model.SchemaURI != "" {
+			response.Result = append(
+				response.Result,
+				lsp.Location{
+					URI: "file://" + model.SchemaURI,
+					Range: lsp.Range{
+						Start: model.SchemaRange.Start,
+						End: lsp.Position{
+							Line:      model.SchemaRange.Start.Line,
+							Character: model.SchemaRange.Start.Character + len(key.Name),
+						},
+					},
+				},
+			)
+		}
+	}
+
+	for _, r := range s.findReferences(ctx, key) {
+		response.Result = append(
+			response.Result,
+			lsp.Location{
+				URI:   "file://" + r.URI,
+				Range: r.Range,
+			},
+		)
+	}
+
+	return response
+}
@@ -0,0 +1,126 @@
+package analysis
+
+import (
+	"context"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/j-clemons/dbt-language-server/lsp"
+	"github.com/j-clemons/dbt-language-server/testutils"
+)
+
+func TestReferences(t *testing.T) {
+	testdataRoot, err := testutils.GetTestdataPath("jaffle_shop_duckdb")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	state := NewState()
+	state.refreshDbtContext(testdataRoot)
+
+	customersPath := filepath.Join(testdataRoot, "models/customers.sql")
+	ordersPath := filepath.Join(testdataRoot, "models/orders.sql")
+
+	openURI := "file:///scratch/models/new_model.sql"
+	state.parseDocument(openURI, "select *\nfrom {{ ref('stg_orders') }}\n")
+
+	tests := []struct {
+		name               string
+		position           lsp.Position
+		includeDeclaration bool
+		expected           []lsp.Location
+	}{
+		{
+			name:               "model references with declaration and schema entry",
+			position:           lsp.Position{Line: 1, Character: 15},
+			includeDeclaration: true,
+			expected: []lsp.Location{
+				{
+					URI:   "file://" + filepath.Join(testdataRoot, "models/staging/stg_orders.sql"),
+					Range: lsp.Range{},
+				},
+				{
+					URI: "file://" + filepath.Join(testdataRoot, "models/staging/schema.yml"),
+					Range: lsp.Range{
+						Start: lsp.Position{Line: 10, Character: 10},
+						End:   lsp.Position{Line: 10, Character: 20},
+					},
+				},
+				{
+					URI: "file://" + customersPath,
+					Range: lsp.Range{
+						Start: lsp.Position{Line: 8, Character: 26},
+						End:   lsp.Position{Line: 8, Character: 36},
+					},
+				},
+				{
+					URI: "file://" + ordersPath,
+					Range: lsp.Range{
+						Start: lsp.Position{Line: 4, Character: 26},
+						End:   lsp.Position{Line: 4, Character: 36},
+					},
+				},
+				{
+					URI: openURI,
+					Range: lsp.Range{
+						Start: lsp.Position{Line: 1, Character: 13},
+						End:   lsp.Position{Line: 1, Character: 23},
+					},
+				},
+			},
+		},
+		{
+			name:               "cursor not on a dbt token",
+			position:           lsp.Position{Line: 0, Character: 2},
+			includeDeclaration: true,
+			expected:           []lsp.Location{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			response := state.References(context.Background(), 1, openURI, tt.position, tt.includeDeclaration)
+
+			if !reflect.DeepEqual(response.Result, tt.expected) {
+				t.Fatalf("expected %v,\n\ngot %v", tt.expected, response.Result)
+			}
+		})
+	}
+}
+
+func TestReferencesOpenDocumentOverridesIndex(t *testing.T) {
+	testdataRoot, err := testutils.GetTestdataPath("jaffle_shop_duckdb")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	state := NewState()
+	state.refreshDbtContext(testdataRoot)
+
+	customersURI := "file://" + filepath.Join(testdataRoot, "models/customers.sql")
+	state.parseDocument(customersURI, "select {{ var('jaffle_string') }}\n\nselect {{ var('jaffle_string') }}")
+
+	response := state.References(context.Background(), 1, customersURI, lsp.Position{Line: 0, Character: 16}, false)
+
+	expected := []lsp.Location{
+		{
+			URI: customersURI,
+			Range: lsp.Range{
+				Start: lsp.Position{Line: 0, Character: 15},
+				End:   lsp.Position{Line: 0, Character: 28},
+			},
+		},
+		{
+			URI: customersURI,
+			Range: lsp.Range{
+				Start: lsp.Position{Line: 2, Character: 15},
+				End:   lsp.Position{Line: 2, Character: 28},
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(response.Result, expected) {
+		t.Fatalf("expected %v,\n\ngot %v", expected, response.Result)
+	}
+}
@@ -0,0 +1,203 @@
+package analysis
+
+import (
+	"context"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/j-clemons/dbt-language-server/analysis/parser"
+	"github.com/j-clemons/dbt-language-server/lsp"
+	"github.com/j-clemons/dbt-language-server/util"
+)
+
+var modelNameRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// renameableRef returns the ref token under the cursor when it points at a
+// model owned by the root project whose file name matches the ref name.
+// Aliased models and package models can't be renamed from here.
+func (s *State) renameableRef(uri string, position lsp.Position) (parser.Token, ModelDetails, bool) {
+	doc, exists := s.Documents[uri]
+	if !exists || doc.Tokens == nil {
+		return parser.Token{}, ModelDetails{}, false
+	}
+
+	cursorTokenLL, err := doc.Tokens.FindTokenAtCursor(position.Line, position.Character)
+	if err != nil || cursorTokenLL.Token.Type != parser.REF {
+		return parser.Token{}, ModelDetails{}, false
+	}
+
+	cursorToken := cursorTokenLL.Token
+	model, ok := s.DbtContext.ModelDetailMap[cursorToken.Literal]
+	if !ok || model.Snapshot || model.ProjectName != s.DbtContext.ProjectYaml.ProjectName.Value {
+		return parser.Token{}, ModelDetails{}, false
+	}
+
+	baseName := filepath.Base(model.URI)
+	if strings.TrimSuffix(baseName, filepath.Ext(baseName)) != cursorToken.Literal {
+		return parser.Token{}, ModelDetails{}, false
+	}
+
+	return cursorToken, model, true
+}
+
+func (s *State) PrepareRename(id lsp.RequestID, uri string, position lsp.Position) lsp.PrepareRenameResponse {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	s = s.project(uri)
+
+	response := lsp.PrepareRenameResponse{
+		Response: lsp.Response{
+			RPC: "2.0",
+			ID:  id,
+		},
+		Result: nil,
+	}
+
+	cursorToken, _, ok := s.renameableRef(uri, position)
+	if !ok {
+		return response
+	}
+
+	response.Result = &lsp.PrepareRenameResult{
+		Range:       tokenRange(cursorToken),
+		Placeholder: cursorToken.Literal,
+	}
+
+	return response
+}
+
+// schemaNameEdit locates the model name in its properties file. The stored
+// position is the start of the YAML scalar, which may be a quote.
+func schemaNameEdit(model ModelDetails, oldName string, newName string) (lsp.TextEdit, bool) {
+	fileContents, err := util.ReadFileContents(model.SchemaURI)
+	if err != nil {
+		return lsp.TextEdit{}, false
+	}
+
+	lines := strings.Split(fileContents, "\n")
+	line := model.SchemaRange.Start.Line
+	character := model.SchemaRange.Start.Character
+	if line >= len(lines) || character > len(lines[line]) {
+		return lsp.TextEdit{}, false
+	}
+
+	idx := strings.Index(lines[line][character:], oldName)
+	if idx == -1 {
+		return lsp.TextEdit{}, false
+	}
+
+	return lsp.TextEdit{
+		Range: lsp.Range{
+			Start: lsp.Position{Line: line, Character: character + idx},
+			End:   lsp.Position{Line: line, Character: character + idx + len(oldName)},
+		},
+		NewText: newName,
+	}, true
+}
+
+func (s *State) Rename(ctx context.Context, id lsp.RequestID, uri string, position lsp.Position, newName string) lsp.RenameResponse {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	s = s.project(uri)
+
+	response := lsp.RenameResponse{
+		Response: lsp.Response{
+			RPC: "2.0",
+			ID:  id,
+		},
+		Result: nil,
+	}
+
+	cursorToken, model, ok := s.renameableRef(uri, position)
+	if !ok || !modelNameRegex.MatchString(newName) || newName == cursorToken.Literal {
+		return response
+	}
+	if _, exists := s.DbtContext.ModelDetailMap[newName]; exists {
+		return response
+	}
+
+	// hub and git packages are replaced by dbt deps, so their refs are left
+	// as is. Local packages are edited in the directory they're installed
+	// from.
+	packagesPath := ""
+	if installPath := s.DbtContext.ProjectYaml.PackagesInstallPath.Value; installPath != "" {
+		packagesPath = filepath.Join(s.DbtContext.ProjectRoot, installPath)
+	}
+	locals := localPackages(s.DbtContext.ProjectRoot, s.DbtContext.ProjectYaml)
+
+	fileEdits := make(map[string][]lsp.TextEdit)
+	seen := make(map[Reference]bool)
+	for _, r := range s.findReferences(ctx, ReferenceKey{Type: parser.REF, Name: cursorToken.Literal}) {
+		if packagesPath != "" && withinDir(r.URI, packagesPath) {
+			source, ok := localPackageSource(r.URI, locals)
+			if !ok {
+				continue
+			}
+			r.URI = source
+		}
+		if seen[r] {
+			continue
+		}
+		seen[r] = true
+		docURI := "file://" + r.URI
+		fileEdits[docURI] = append(fileEdits[docURI], lsp.TextEdit{Range: r.Range, NewText: newName})
+	}
+
+	if model.SchemaURI != "" {
+		if edit, found := schemaNameEdit(model, cursorToken.Literal, newName); found {
+			schemaURI := "file://" + model.SchemaURI
+			fileEdits[schemaURI] = append(fileEdits[schemaURI], edit)
+		}
+	}
+
+	editURIs := make([]string, 0, len(fileEdits))
+	for k := range fileEdits {
+		editURIs = append(editURIs, k)
+	}
+	sort.Strings(editURIs)
+
+	workspaceEdit := lsp.WorkspaceEdit{DocumentChanges: []any{}}
+	for _, editURI := range editURIs {
+		workspaceEdit.DocumentChanges = append(
+			workspaceEdit.DocumentChanges,
+			lsp.TextDocumentEdit{
+				TextDocument: lsp.OptionalVersionedTextDocumentIdentifier{
+					TextDocumentIdentifier: lsp.TextDocumentIdentifier{URI: editURI},
+				},
+				Edits: fileEdits[editURI],
+			},
+		)
+	}
+
+	// The file is renamed last so the text edits above still target paths
+	// that exist when the client applies them.
+	newPath := filepath.Join(filepath.Dir(model.URI), newName+filepath.Ext(model.URI))
+	workspaceEdit.DocumentChanges = append(
+		workspaceEdit.DocumentChanges,
+		lsp.RenameFile{
+			Kind:   "rename",
+			OldURI: "file://" + model.URI,
+			NewURI: "file://" + newPath,
+		},
+	)
+
+	response.Result = &workspaceEdit
+	return response
+}
+
+// localPackageSource maps a path in an installed local package to the file
+// it was installed from.
+func localPackageSource(path string, locals map[string]string) (string, bool) {
+	for installDir, source := range locals {
+		if withinDir(path, installDir) {
+			rel, err := filepath.Rel(installDir, path)
+			if err != nil {
+				return "", false
+			}
+			return filepath.Join(source, rel), true
+		}
+	}
+	return "", false
+}
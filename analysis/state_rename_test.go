@@ -0,0 +1,226 @@
+package analysis
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/j-clemons/dbt-language-server/lsp"
+	"github.com/j-clemons/dbt-language-server/testutils"
+	"github.com/j-clemons/dbt-language-server/util"
+)
+
+func TestPrepareRename(t *testing.T) {
+	testdataRoot, err := testutils.GetTestdataPath("jaffle_shop_duckdb")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	state := NewState()
+	state.refreshDbtContext(testdataRoot)
+
+	uri := "file:///scratch/models/new_model.sql"
+	state.parseDocument(uri, "select *\nfrom {{ ref('stg_orders') }}\njoin {{ ref('stg_customer_status') }}")
+
+	tests := []struct {
+		name     string
+		position lsp.Position
+		expected *lsp.PrepareRenameResult
+	}{
+		{
+			name:     "project model",
+			position: lsp.Position{Line: 1, Character: 15},
+			expected: &lsp.PrepareRenameResult{
+				Range: lsp.Range{
+					Start: lsp.Position{Line: 1, Character: 13},
+					End:   lsp.Position{Line: 1, Character: 23},
+				},
+				Placeholder: "stg_orders",
+			},
+		},
+		{
+			name:     "package model",
+			position: lsp.Position{Line: 2, Character: 15},
+			expected: nil,
+		},
+		{
+			name:     "not a ref",
+			position: lsp.Position{Line: 0, Character: 1},
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			response := state.PrepareRename(1, uri, tt.position)
+			if !reflect.DeepEqual(response.Result, tt.expected) {
+				t.Fatalf("expected %v, got %v", tt.expected, response.Result)
+			}
+		})
+	}
+}
+
+func TestRename(t *testing.T) {
+	testdataRoot, err := testutils.GetTestdataPath("jaffle_shop_duckdb")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	state := NewState()
+	state.refreshDbtContext(testdataRoot)
+
+	uri := "file://" + filepath.Join(testdataRoot, "models/orders.sql")
+	fileContents, err := util.ReadFileContents(filepath.Join(testdataRoot, "models/orders.sql"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	state.parseDocument(uri, fileContents)
+
+	response := state.Rename(context.Background(), 1, uri, lsp.Position{Line: 4, Character: 28}, "stg_orders_renamed")
+	if response.Result == nil {
+		t.Fatal("expected a workspace edit, got nil")
+	}
+
+	refEdit := func(line int) lsp.TextEdit {
+		return lsp.TextEdit{
+			Range: lsp.Range{
+				Start: lsp.Position{Line: line, Character: 26},
+				End:   lsp.Position{Line: line, Character: 36},
+			},
+			NewText: "stg_orders_renamed",
+		}
+	}
+
+	expected := []any{
+		lsp.TextDocumentEdit{
+			TextDocument: lsp.OptionalVersionedTextDocumentIdentifier{
+				TextDocumentIdentifier: lsp.TextDocumentIdentifier{
+					URI: "file://" + filepath.Join(testdataRoot, "models/customers.sql"),
+				},
+			},
+			Edits: []lsp.TextEdit{refEdit(8)},
+		},
+		lsp.TextDocumentEdit{
+			TextDocument: lsp.OptionalVersionedTextDocumentIdentifier{
+				TextDocumentIdentifier: lsp.TextDocumentIdentifier{URI: uri},
+			},
+			Edits: []lsp.TextEdit{refEdit(4)},
+		},
+		lsp.TextDocumentEdit{
+			TextDocument: lsp.OptionalVersionedTextDocumentIdentifier{
+				TextDocumentIdentifier: lsp.TextDocumentIdentifier{
+					URI: "file://" + filepath.Join(testdataRoot, "models/staging/schema.yml"),
+				},
+			},
+			Edits: []lsp.TextEdit{
+				{
+					Range: lsp.Range{
+						Start: lsp.Position{Line: 10, Character: 10},
+						End:   lsp.Position{Line: 10, Character: 20},
+					},
+					NewText: "stg_orders_renamed",
+				},
+			},
+		},
+		lsp.RenameFile{
+			Kind:   "rename",
+			OldURI: "file://" + filepath.Join(testdataRoot, "models/staging/stg_orders.sql"),
+			NewURI: "file://" + filepath.Join(testdataRoot, "models/staging/stg_orders_renamed.sql"),
+		},
+	}
+
+	if !reflect.DeepEqual(response.Result.DocumentChanges, expected) {
+		t.Fatalf("expected %v,\n\ngot %v", expected, response.Result.DocumentChanges)
+	}
+
+	invalid := state.Rename(context.Background(), 1, uri, lsp.Position{Line: 4, Character: 28}, "customers")
+	if invalid.Result != nil {
+		t.Fatalf("expected nil result when renaming onto an existing model, got %v", invalid.Result)
+	}
+}
+
+func TestRenameSkipsInstalledPackages(t *testing.T) {
+	testdataRoot, err := testutils.GetTestdataPath("versioned_project")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	state := NewState()
+	state.refreshDbtContext(testdataRoot)
+
+	// the shared package has its own orders model, and refs orders itself
+	uri := "file://" + filepath.Join(testdataRoot, "models/report.sql")
+	state.parseDocument(uri, "select * from {{ ref('orders') }}\njoin {{ ref('shared', 'orders') }}\n")
+
+	response := state.Rename(context.Background(), 1, uri, lsp.Position{Line: 0, Character: 22}, "orders_renamed")
+	if response.Result == nil {
+		t.Fatal("expected a workspace edit")
+	}
+
+	edits := map[string][]int{}
+	for _, change := range response.Result.DocumentChanges {
+		if edit, ok := change.(lsp.TextDocumentEdit); ok {
+			for _, textEdit := range edit.Edits {
+				edits[edit.TextDocument.URI] = append(edits[edit.TextDocument.URI], textEdit.Range.Start.Line)
+			}
+		}
+	}
+	expected := map[string][]int{
+		uri: {0},
+		"file://" + filepath.Join(testdataRoot, "models/dim_customers_v1.sql"): {0},
+	}
+	if !reflect.DeepEqual(edits, expected) {
+		t.Errorf("expected edits %v, got %v", expected, edits)
+	}
+}
+
+func TestRenameEditsLocalPackages(t *testing.T) {
+	root := testutils.CopyTestdata(t, "versioned_project")
+	files := map[string]string{
+		"packages.yml":                                    "packages:\n  - package: acme/shared\n    version: 1.0.0\n  - local: local_packages/helpers\n",
+		"local_packages/helpers/dbt_project.yml":          "name: helpers\n",
+		"local_packages/helpers/models/helper_orders.sql": "select * from {{ ref('orders') }}",
+	}
+	for name, contents := range files {
+		path := filepath.Join(root, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(contents), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// dbt deps links local packages into the install path
+	if err := os.Symlink(filepath.Join(root, "local_packages/helpers"), filepath.Join(root, "dbt_packages/helpers")); err != nil {
+		t.Fatal(err)
+	}
+
+	state := NewState()
+	state.refreshDbtContext(root)
+
+	uri := "file://" + filepath.Join(root, "models/dim_customers_v1.sql")
+	state.parseDocument(uri, "select 1 as customer_id from {{ ref('orders') }}")
+	response := state.Rename(context.Background(), 1, uri, lsp.Position{Line: 0, Character: 40}, "orders_renamed")
+	if response.Result == nil {
+		t.Fatal("expected a workspace edit")
+	}
+
+	edited := []string{}
+	for _, change := range response.Result.DocumentChanges {
+		if edit, ok := change.(lsp.TextDocumentEdit); ok {
+			edited = append(edited, edit.TextDocument.URI)
+		}
+	}
+	// the hub package's extra.sql refs orders too, but is left as is
+	expected := []string{
+		uri,
+		"file://" + filepath.Join(root, "local_packages/helpers/models/helper_orders.sql"),
+	}
+	sort.Strings(expected)
+	if !reflect.DeepEqual(edited, expected) {
+		t.Errorf("expected edits to %v, got %v", expected, edited)
+	}
+}
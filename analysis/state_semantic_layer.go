@@ -0,0 +1,205 @@
+package analysis
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/j-clemons/dbt-language-server/lsp"
+	"github.com/j-clemons/dbt-language-server/lsp/completionKind"
+)
+
+// semanticCallRegex matches a MetricFlow object being typed in a filter or
+// group_by, e.g. `{{ Dimension('customer__`.
+var semanticCallRegex = regexp.MustCompile(`\b(Dimension|TimeDimension|Entity)\(\s*['"][\w]*$`)
+
+// semanticNodeMarkdown is the hover text of a metric or other semantic node.
+func semanticNodeMarkdown(node SemanticNode) string {
+	title := node.Name
+	if node.Label != "" {
+		title = node.Label
+	}
+	header := fmt.Sprintf("**%s**", title)
+	if node.Type != "" {
+		header += fmt.Sprintf(" (%s)", node.Type)
+	}
+	return strings.TrimSpace(header + "\n\n" + node.Description)
+}
+
+// sortedSemanticNodes lists the nodes of a kind by name.
+func (s *State) sortedSemanticNodes(kind SemanticKind) []SemanticNode {
+	nodes := []SemanticNode{}
+	for _, node := range s.DbtContext.SemanticDetailMap[kind] {
+		nodes = append(nodes, node)
+	}
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].Name < nodes[j].Name })
+	return nodes
+}
+
+func (s *State) metricItems(suffix string) []lsp.CompletionItem {
+	items := []lsp.CompletionItem{}
+	for _, metric := range s.sortedSemanticNodes(MetricKind) {
+		items = append(items, lsp.CompletionItem{
+			Label:         metric.Name,
+			Detail:        fmt.Sprintf("Metric: %s", metric.Type),
+			Documentation: metric.Description,
+			Kind:          completionKind.Value,
+			InsertText:    metric.Name + suffix,
+			SortText:      metric.Name,
+		})
+	}
+	return items
+}
+
+func (s *State) measureItems() []lsp.CompletionItem {
+	items := []lsp.CompletionItem{}
+	for _, model := range s.sortedSemanticNodes(SemanticModelKind) {
+		for _, measure := range model.Measures {
+			items = append(items, lsp.CompletionItem{
+				Label:         measure.Name,
+				Detail:        fmt.Sprintf("Measure: %s (%s)", model.Name, measure.Type),
+				Documentation: measure.Description,
+				Kind:          completionKind.Field,
+				InsertText:    measure.Name,
+				SortText:      measure.Name,
+			})
+		}
+	}
+	return items
+}
+
+// primaryEntity is the entity that prefixes a semantic model's dimensions,
+// as in `order__status`.
+func primaryEntity(model SemanticNode) string {
+	for _, entity := range model.Entities {
+		if entity.Type == "primary" || entity.Type == "natural" {
+			return entity.Name
+		}
+	}
+	return ""
+}
+
+// semanticObjectItems completes the argument of Entity(), Dimension() and
+// TimeDimension(). Dimensions are qualified with their model's primary
+// entity.
+func (s *State) semanticObjectItems(call string) []lsp.CompletionItem {
+	items := []lsp.CompletionItem{}
+	seen := map[string]bool{}
+	add := func(name, detail, documentation string) {
+		if seen[name] {
+			return
+		}
+		seen[name] = true
+		items = append(items, lsp.CompletionItem{
+			Label:         name,
+			Detail:        detail,
+			Documentation: documentation,
+			Kind:          completionKind.Field,
+			InsertText:    name,
+			SortText:      name,
+		})
+	}
+
+	if call == "TimeDimension" {
+		add("metric_time", "Time dimension", "The aggregation time dimension of each metric")
+	}
+	for _, model := range s.sortedSemanticNodes(SemanticModelKind) {
+		if call == "Entity" {
+			for _, entity := range model.Entities {
+				add(entity.Name, fmt.Sprintf("Entity: %s (%s)", model.Name, entity.Type), entity.Description)
+			}
+			continue
+		}
+		entity := primaryEntity(model)
+		for _, dimension := range model.Dimensions {
+			if call == "TimeDimension" && dimension.Type != "time" {
+				continue
+			}
+			name := dimension.Name
+			if entity != "" {
+				name = entity + "__" + dimension.Name
+			}
+			add(name, fmt.Sprintf("Dimension: %s (%s)", model.Name, dimension.Type), dimension.Description)
+		}
+	}
+	return items
+}
+
+// timeDimensionItems completes `agg_time_dimension:` with the time
+// dimensions of the semantic model being edited.
+func (s *State) timeDimensionItems(modelName string) []lsp.CompletionItem {
+	items := []lsp.CompletionItem{}
+	model := s.DbtContext.SemanticDetailMap[SemanticModelKind][modelName]
+	if doc, ok := s.semanticModelInDocument(modelName); ok {
+		model = doc
+	}
+	for _, dimension := range model.Dimensions {
+		if dimension.Type != "time" {
+			continue
+		}
+		items = append(items, lsp.CompletionItem{
+			Label:         dimension.Name,
+			Detail:        "Time dimension",
+			Documentation: dimension.Description,
+			Kind:          completionKind.Field,
+			InsertText:    dimension.Name,
+			SortText:      dimension.Name,
+		})
+	}
+	return items
+}
+
+// semanticModelInDocument reads a semantic model from the open documents,
+// so dimensions added since the last save complete too.
+func (s *State) semanticModelInDocument(name string) (SemanticNode, bool) {
+	for _, doc := range s.Documents {
+		if doc.Yaml == nil {
+			continue
+		}
+		for _, sm := range doc.Yaml.Properties.SemanticModels {
+			if sm.Name.Value == name {
+				return SemanticNode{
+					Name:       name,
+					Kind:       SemanticModelKind,
+					Entities:   semanticElements(sm.Entities),
+					Dimensions: semanticElements(sm.Dimensions),
+					Measures:   semanticElements(sm.Measures),
+				}, true
+			}
+		}
+	}
+	return SemanticNode{}, false
+}
+
+// semanticYamlItems completes names in the metrics, semantic models and
+// saved queries of a properties file.
+func (s *State) semanticYamlItems(doc Document, position lsp.Position) ([]lsp.CompletionItem, bool) {
+	if position.Line >= 0 && position.Line < len(doc.Yaml.Lines) {
+		line := doc.Yaml.Lines[position.Line]
+		if position.Character >= 0 && position.Character <= len(line) {
+			if m := semanticCallRegex.FindStringSubmatch(line[:position.Character]); m != nil {
+				return s.semanticObjectItems(m[1]), true
+			}
+		}
+	}
+
+	ctx, ok := doc.Yaml.contextAt(position.Line)
+	if !ok || !onYamlValue(position, ctx) {
+		return nil, false
+	}
+	switch {
+	case ctx.Key == "measure" && ctx.path() == "metrics.type_params",
+		ctx.Key == "name" && ctx.path() == "metrics.type_params.measure":
+		return s.measureItems(), true
+	case ctx.Key == "name" && ctx.path() == "metrics.type_params.metrics",
+		ctx.Key == "name" && ctx.path() == "metrics.type_params.numerator",
+		ctx.Key == "name" && ctx.path() == "metrics.type_params.denominator",
+		(ctx.Key == "numerator" || ctx.Key == "denominator") && ctx.path() == "metrics.type_params",
+		ctx.Key == "" && ctx.path() == "saved_queries.query_params.metrics":
+		return s.metricItems(""), true
+	case ctx.Key == "agg_time_dimension" && len(ctx.Keys) > 0 && ctx.Keys[0] == "semantic_models":
+		return s.timeDimensionItems(ctx.name(0)), true
+	}
+	return nil, false
+}
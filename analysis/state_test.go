@@ -3,20 +3,22 @@ package analysis
 import (
 	"path/filepath"
 	"reflect"
+	"sync"
 	"testing"
 
+	"github.com/j-clemons/dbt-language-server/analysis/parser"
 	"github.com/j-clemons/dbt-language-server/docs"
 	"github.com/j-clemons/dbt-language-server/lsp"
 	"github.com/j-clemons/dbt-language-server/testutils"
 )
 
-func expectedTestState() State {
+func expectedTestState() *State {
 	testdataRoot, err := testutils.GetTestdataPath("jaffle_shop_duckdb")
 	if err != nil {
 		panic(err)
 	}
 
-	expectedState := State{
+	expectedState := &State{
 		Documents: map[string]Document{},
 		DbtContext: DbtContext{
 			ProjectRoot: testdataRoot,
@@ -56,6 +58,16 @@ func expectedTestState() State {
 						Character: 13,
 					},
 				},
+				SnapshotPaths: AnnotatedField[[]string]{
+					Value: []string{"snapshots"},
+				},
+				TestPaths: AnnotatedField[[]string]{
+					Value: []string{"tests"},
+					Position: lsp.Position{
+						Line:      9,
+						Character: 12,
+					},
+				},
 				PackagesInstallPath: AnnotatedField[string]{
 					Value: "dbt_packages",
 					Position: lsp.Position{
@@ -70,6 +82,13 @@ func expectedTestState() State {
 						Character: 0,
 					},
 				},
+				TargetPath: AnnotatedField[string]{
+					Value: "target",
+					Position: lsp.Position{
+						Line:      13,
+						Character: 13,
+					},
+				},
 				Vars: AnnotatedMap{
 					"global_count": AnnotatedField[interface{}]{
 						Value: 0,
@@ -101,6 +120,16 @@ func expectedTestState() State {
 						},
 					},
 				},
+				Models: map[string]any{
+					"jaffle_shop": map[string]any{
+						"materialized": "table",
+						"staging": map[string]any{
+							"materialized": "view",
+							"+docs":        map[string]any{"node_color": "silver"},
+						},
+						"+docs": map[string]any{"node_color": "gold"},
+					},
+				},
 			},
 			Dialect: docs.Dialect("duckdb"),
 			ModelDetailMap: map[string]ModelDetails{
@@ -113,6 +142,11 @@ func expectedTestState() State {
 						Start: lsp.Position{Line: 3, Character: 10},
 						End:   lsp.Position{Line: 3, Character: 10},
 					},
+					InferredColumns: columnsNamed(
+						"jaffle_string", "customer_id", "first_name", "last_name", "full_name",
+						"first_order", "most_recent_order", "number_of_orders", "lifetime_order_number",
+						"customer_lifetime_value",
+					),
 				},
 				"orders": {
 					URI:         filepath.Join(testdataRoot, "models/orders.sql"),
@@ -133,6 +167,7 @@ func expectedTestState() State {
 						Start: lsp.Position{Line: 0, Character: 0},
 						End:   lsp.Position{Line: 0, Character: 0},
 					},
+					InferredColumns: columnsNamed("customer_id", "status"),
 				},
 				"stg_customers": {
 					URI:         filepath.Join(testdataRoot, "models/staging/stg_customers.sql"),
@@ -143,6 +178,7 @@ func expectedTestState() State {
 						Start: lsp.Position{Line: 3, Character: 10},
 						End:   lsp.Position{Line: 3, Character: 10},
 					},
+					InferredColumns: columnsNamed("customer_id", "first_name", "last_name"),
 				},
 				"stg_orders": {
 					URI:         filepath.Join(testdataRoot, "models/staging/stg_orders.sql"),
@@ -153,6 +189,7 @@ func expectedTestState() State {
 						Start: lsp.Position{Line: 10, Character: 10},
 						End:   lsp.Position{Line: 10, Character: 10},
 					},
+					InferredColumns: columnsNamed("order_id", "customer_id", "order_date", "status"),
 				},
 				"stg_payments": {
 					URI:         filepath.Join(testdataRoot, "models/staging/stg_payments.sql"),
@@ -163,6 +200,7 @@ func expectedTestState() State {
 						Start: lsp.Position{Line: 21, Character: 10},
 						End:   lsp.Position{Line: 21, Character: 10},
 					},
+					InferredColumns: columnsNamed("payment_id", "order_id", "payment_method", "amount"),
 				},
 				"raw_customers": {
 					URI:         filepath.Join(testdataRoot, "seeds/raw_customers.csv"),
@@ -173,6 +211,7 @@ func expectedTestState() State {
 						Start: lsp.Position{Line: 0, Character: 0},
 						End:   lsp.Position{Line: 0, Character: 0},
 					},
+					InferredColumns: columnsNamed("id", "first_name", "last_name"),
 				},
 				"raw_orders": {
 					URI:         filepath.Join(testdataRoot, "seeds/raw_orders.csv"),
@@ -183,6 +222,7 @@ func expectedTestState() State {
 						Start: lsp.Position{Line: 0, Character: 0},
 						End:   lsp.Position{Line: 0, Character: 0},
 					},
+					InferredColumns: columnsNamed("id", "user_id", "order_date", "status"),
 				},
 				"raw_payments": {
 					URI:         filepath.Join(testdataRoot, "seeds/raw_payments.csv"),
@@ -193,11 +233,43 @@ func expectedTestState() State {
 						Start: lsp.Position{Line: 0, Character: 0},
 						End:   lsp.Position{Line: 0, Character: 0},
 					},
+					InferredColumns: columnsNamed("id", "order_id", "payment_method", "amount"),
+				},
+				"orders_snapshot": {
+					URI:         filepath.Join(testdataRoot, "snapshots/orders_snapshot.sql"),
+					ProjectName: "jaffle_shop",
+					Description: "History of order status changes",
+					SchemaURI:   filepath.Join(testdataRoot, "snapshots/snapshots.yml"),
+					SchemaRange: lsp.Range{
+						Start: lsp.Position{Line: 3, Character: 10},
+						End:   lsp.Position{Line: 3, Character: 10},
+					},
+					Range: lsp.Range{
+						Start: lsp.Position{Line: 0, Character: 12},
+						End:   lsp.Position{Line: 0, Character: 27},
+					},
+					Snapshot: true,
+				},
+				"customers_snapshot": {
+					URI:         filepath.Join(testdataRoot, "snapshots/snapshots.yml"),
+					ProjectName: "jaffle_shop",
+					Description: "Snapshot",
+					SchemaURI:   filepath.Join(testdataRoot, "snapshots/snapshots.yml"),
+					SchemaRange: lsp.Range{
+						Start: lsp.Position{Line: 6, Character: 10},
+						End:   lsp.Position{Line: 6, Character: 10},
+					},
+					Range: lsp.Range{
+						Start: lsp.Position{Line: 6, Character: 10},
+						End:   lsp.Position{Line: 6, Character: 10},
+					},
+					Snapshot: true,
 				},
 			},
 			SourceDetailMap: map[string]Source{
 				"jaffle_shop": {
 					Name:        "jaffle_shop",
+					ProjectName: "jaffle_shop",
 					Description: "",
 					URI:         filepath.Join(testdataRoot, "models/schema.yml"),
 					Range: lsp.Range{
@@ -229,6 +301,7 @@ func expectedTestState() State {
 				},
 				"stripe": {
 					Name:        "stripe",
+					ProjectName: "jaffle_shop",
 					Description: "",
 					URI:         filepath.Join(testdataRoot, "models/schema.yml"),
 					Range: lsp.Range{
@@ -255,6 +328,7 @@ func expectedTestState() State {
 						Name:        "add_values",
 						ProjectName: "jaffle_package",
 						Description: "add_values(arg1, arg2)",
+						Arguments:   []MacroArgument{{Name: "arg1"}, {Name: "arg2"}},
 						URI:         filepath.Join(testdataRoot, "dbt_packages/jaffle_package/macros/jaffle_package_macros.sql"),
 						Range: lsp.Range{
 							Start: lsp.Position{
@@ -270,10 +344,15 @@ func expectedTestState() State {
 				},
 				"jaffle_shop": {
 					"full_name": {
-						Name:        "full_name",
-						ProjectName: "jaffle_shop",
-						Description: "full_name(first_name, last_name)",
-						URI:         filepath.Join(testdataRoot, "macros/jaffle_macros.sql"),
+						Name:          "full_name",
+						ProjectName:   "jaffle_shop",
+						Description:   "full_name(first_name, last_name)",
+						Documentation: "Concatenates a first and last name.",
+						Arguments: []MacroArgument{
+							{Name: "first_name", Type: "string", Description: "The given name column."},
+							{Name: "last_name", Type: "string", Description: "The family name column."},
+						},
+						URI: filepath.Join(testdataRoot, "macros/jaffle_macros.sql"),
 						Range: lsp.Range{
 							Start: lsp.Position{
 								Line:      0,
@@ -289,6 +368,7 @@ func expectedTestState() State {
 						Name:        "times_five",
 						ProjectName: "jaffle_shop",
 						Description: "times_five(int_value)",
+						Arguments:   []MacroArgument{{Name: "int_value"}},
 						URI:         filepath.Join(testdataRoot, "macros/jaffle_macros.sql"),
 						Range: lsp.Range{
 							Start: lsp.Position{
@@ -350,8 +430,501 @@ func expectedTestState() State {
 					},
 				},
 			},
+			PackageModelMap: map[Package]map[string]ModelDetails{
+				"jaffle_package": {
+					"stg_customer_status": {
+						URI:             filepath.Join(testdataRoot, "dbt_packages/jaffle_package/models/stg_customer_status.sql"),
+						ProjectName:     "jaffle_package",
+						InferredColumns: columnsNamed("customer_id", "status"),
+					},
+				},
+				"jaffle_shop": {
+					"customers": {
+						URI:         filepath.Join(testdataRoot, "models/customers.sql"),
+						ProjectName: "jaffle_shop",
+						Description: "This table has basic information about a customer, as well as some derived facts based on a customer's orders",
+						SchemaURI:   filepath.Join(testdataRoot, "models/schema.yml"),
+						SchemaRange: lsp.Range{
+							Start: lsp.Position{Line: 3, Character: 10},
+							End:   lsp.Position{Line: 3, Character: 10},
+						},
+						InferredColumns: columnsNamed(
+							"jaffle_string", "customer_id", "first_name", "last_name", "full_name",
+							"first_order", "most_recent_order", "number_of_orders", "lifetime_order_number",
+							"customer_lifetime_value",
+						),
+					},
+					"customers_snapshot": {
+						URI:         filepath.Join(testdataRoot, "snapshots/snapshots.yml"),
+						ProjectName: "jaffle_shop",
+						Description: "Snapshot",
+						SchemaURI:   filepath.Join(testdataRoot, "snapshots/snapshots.yml"),
+						SchemaRange: lsp.Range{
+							Start: lsp.Position{Line: 6, Character: 10},
+							End:   lsp.Position{Line: 6, Character: 10},
+						},
+						Range: lsp.Range{
+							Start: lsp.Position{Line: 6, Character: 10},
+							End:   lsp.Position{Line: 6, Character: 10},
+						},
+						Snapshot: true,
+					},
+					"orders": {
+						URI:         filepath.Join(testdataRoot, "models/orders.sql"),
+						ProjectName: "jaffle_shop",
+						Description: "This table has basic information about orders, as well as some derived facts based on payments",
+						SchemaURI:   filepath.Join(testdataRoot, "models/schema.yml"),
+						SchemaRange: lsp.Range{
+							Start: lsp.Position{Line: 31, Character: 10},
+							End:   lsp.Position{Line: 31, Character: 10},
+						},
+					},
+					"orders_snapshot": {
+						URI:         filepath.Join(testdataRoot, "snapshots/orders_snapshot.sql"),
+						ProjectName: "jaffle_shop",
+						Description: "History of order status changes",
+						SchemaURI:   filepath.Join(testdataRoot, "snapshots/snapshots.yml"),
+						SchemaRange: lsp.Range{
+							Start: lsp.Position{Line: 3, Character: 10},
+							End:   lsp.Position{Line: 3, Character: 10},
+						},
+						Range: lsp.Range{
+							Start: lsp.Position{Character: 12},
+							End:   lsp.Position{Character: 27},
+						},
+						Snapshot: true,
+					},
+					"raw_customers": {
+						URI:             filepath.Join(testdataRoot, "seeds/raw_customers.csv"),
+						ProjectName:     "jaffle_shop",
+						Description:     "Seed File",
+						InferredColumns: columnsNamed("id", "first_name", "last_name"),
+					},
+					"raw_orders": {
+						URI:             filepath.Join(testdataRoot, "seeds/raw_orders.csv"),
+						ProjectName:     "jaffle_shop",
+						Description:     "Seed File",
+						InferredColumns: columnsNamed("id", "user_id", "order_date", "status"),
+					},
+					"raw_payments": {
+						URI:             filepath.Join(testdataRoot, "seeds/raw_payments.csv"),
+						ProjectName:     "jaffle_shop",
+						Description:     "Seed File",
+						InferredColumns: columnsNamed("id", "order_id", "payment_method", "amount"),
+					},
+					"stg_customers": {
+						URI:         filepath.Join(testdataRoot, "models/staging/stg_customers.sql"),
+						ProjectName: "jaffle_shop",
+						SchemaURI:   filepath.Join(testdataRoot, "models/staging/schema.yml"),
+						SchemaRange: lsp.Range{
+							Start: lsp.Position{Line: 3, Character: 10},
+							End:   lsp.Position{Line: 3, Character: 10},
+						},
+						InferredColumns: columnsNamed("customer_id", "first_name", "last_name"),
+					},
+					"stg_orders": {
+						URI:         filepath.Join(testdataRoot, "models/staging/stg_orders.sql"),
+						ProjectName: "jaffle_shop",
+						SchemaURI:   filepath.Join(testdataRoot, "models/staging/schema.yml"),
+						SchemaRange: lsp.Range{
+							Start: lsp.Position{Line: 10, Character: 10},
+							End:   lsp.Position{Line: 10, Character: 10},
+						},
+						InferredColumns: columnsNamed("order_id", "customer_id", "order_date", "status"),
+					},
+					"stg_payments": {
+						URI:         filepath.Join(testdataRoot, "models/staging/stg_payments.sql"),
+						ProjectName: "jaffle_shop",
+						SchemaURI:   filepath.Join(testdataRoot, "models/staging/schema.yml"),
+						SchemaRange: lsp.Range{
+							Start: lsp.Position{Line: 21, Character: 10},
+							End:   lsp.Position{Line: 21, Character: 10},
+						},
+						InferredColumns: columnsNamed("payment_id", "order_id", "payment_method", "amount"),
+					},
+				},
+			},
+			DocsDetailMap: map[Package]map[string]Docs{
+				"jaffle_shop": {
+					"__overview__": {
+						Name:        "__overview__",
+						Content:     "## Data Documentation for Jaffle Shop\n\n`jaffle_shop` is a fictional ecommerce store.\n\nThis [dbt](https://www.getdbt.com/) project is for testing out code.\n\nThe source code can be found [here](https://github.com/clrcrl/jaffle_shop).",
+						ProjectName: "jaffle_shop",
+						URI:         filepath.Join(testdataRoot, "models/overview.md"),
+						Range: lsp.Range{
+							Start: lsp.Position{Character: 8},
+							End:   lsp.Position{Character: 20},
+						},
+					},
+					"orders_status": {
+						Name:        "orders_status",
+						Content:     "Orders can be one of the following statuses:\n\n| status         | description                                                                                                            |\n|----------------|------------------------------------------------------------------------------------------------------------------------|\n| placed         | The order has been placed but has not yet left the warehouse                                                           |\n| shipped        | The order has ben shipped to the customer and is currently in transit                                                  |\n| completed      | The order has been received by the customer                                                                            |\n| return_pending | The customer has indicated that they would like to return the order, but it has not yet been received at the warehouse |\n| returned       | The order has been returned by the customer and received at the warehouse                                              |",
+						ProjectName: "jaffle_shop",
+						URI:         filepath.Join(testdataRoot, "models/docs.md"),
+						Range: lsp.Range{
+							Start: lsp.Position{Character: 8},
+							End:   lsp.Position{Character: 21},
+						},
+					},
+				},
+			},
+			ReferenceIndex: map[ReferenceKey][]Reference{
+				{Type: parser.MACRO, Package: "jaffle_package", Name: "add_values"}: {
+					{
+						Key: ReferenceKey{Type: parser.MACRO, Package: "jaffle_package", Name: "add_values"},
+						URI: filepath.Join(testdataRoot, "models/customers.sql"),
+						Range: lsp.Range{
+							Start: lsp.Position{Line: 66, Character: 26},
+							End:   lsp.Position{Line: 66, Character: 36},
+						},
+						Qualified: true,
+					},
+				},
+				{Type: parser.MACRO, Package: "jaffle_shop", Name: "full_name"}: {
+					{
+						Key: ReferenceKey{Type: parser.MACRO, Package: "jaffle_shop", Name: "full_name"},
+						URI: filepath.Join(testdataRoot, "models/customers.sql"),
+						Range: lsp.Range{
+							Start: lsp.Position{Line: 60, Character: 11},
+							End:   lsp.Position{Line: 60, Character: 20},
+						},
+					},
+				},
+				{Type: parser.MACRO, Package: "jaffle_shop", Name: "times_five"}: {
+					{
+						Key: ReferenceKey{Type: parser.MACRO, Package: "jaffle_shop", Name: "times_five"},
+						URI: filepath.Join(testdataRoot, "models/customers.sql"),
+						Range: lsp.Range{
+							Start: lsp.Position{Line: 64, Character: 11},
+							End:   lsp.Position{Line: 64, Character: 21},
+						},
+					},
+				},
+				{Type: parser.METRIC, Name: "revenue"}: {
+					{
+						Key: ReferenceKey{Type: parser.METRIC, Name: "revenue"},
+						URI: filepath.Join(testdataRoot, "models/semantic.yml"),
+						Range: lsp.Range{
+							Start: lsp.Position{Line: 64, Character: 16},
+							End:   lsp.Position{Line: 64, Character: 23},
+						},
+					},
+				},
+				{Type: parser.REF, Name: "customers"}: {
+					{
+						Key: ReferenceKey{Type: parser.REF, Name: "customers"},
+						URI: filepath.Join(testdataRoot, "models/semantic.yml"),
+						Range: lsp.Range{
+							Start: lsp.Position{Line: 63, Character: 13},
+							End:   lsp.Position{Line: 63, Character: 22},
+						},
+					},
+				},
+				{Type: parser.REF, Name: "orders"}: {
+					{
+						Key: ReferenceKey{Type: parser.REF, Name: "orders"},
+						URI: filepath.Join(testdataRoot, "models/semantic.yml"),
+						Range: lsp.Range{
+							Start: lsp.Position{Line: 5, Character: 16},
+							End:   lsp.Position{Line: 5, Character: 22},
+						},
+					},
+				},
+				{Type: parser.REF, Name: "raw_customers"}: {
+					{
+						Key: ReferenceKey{Type: parser.REF, Name: "raw_customers"},
+						URI: filepath.Join(testdataRoot, "models/staging/stg_customers.sql"),
+						Range: lsp.Range{
+							Start: lsp.Position{Line: 6, Character: 26},
+							End:   lsp.Position{Line: 6, Character: 39},
+						},
+					},
+				},
+				{Type: parser.REF, Name: "raw_orders"}: {
+					{
+						Key: ReferenceKey{Type: parser.REF, Name: "raw_orders"},
+						URI: filepath.Join(testdataRoot, "models/staging/stg_orders.sql"),
+						Range: lsp.Range{
+							Start: lsp.Position{Line: 6, Character: 26},
+							End:   lsp.Position{Line: 6, Character: 36},
+						},
+					},
+					{
+						Key: ReferenceKey{Type: parser.REF, Name: "raw_orders"},
+						URI: filepath.Join(testdataRoot, "snapshots/orders_snapshot.sql"),
+						Range: lsp.Range{
+							Start: lsp.Position{Line: 11, Character: 22},
+							End:   lsp.Position{Line: 11, Character: 32},
+						},
+					},
+				},
+				{Type: parser.REF, Name: "raw_payments"}: {
+					{
+						Key: ReferenceKey{Type: parser.REF, Name: "raw_payments"},
+						URI: filepath.Join(testdataRoot, "models/staging/stg_payments.sql"),
+						Range: lsp.Range{
+							Start: lsp.Position{Line: 6, Character: 26},
+							End:   lsp.Position{Line: 6, Character: 38},
+						},
+					},
+				},
+				{Type: parser.REF, Name: "stg_customer_status"}: {
+					{
+						Key: ReferenceKey{Type: parser.REF, Name: "stg_customer_status"},
+						URI: filepath.Join(testdataRoot, "models/customers.sql"),
+						Range: lsp.Range{
+							Start: lsp.Position{Line: 20, Character: 26},
+							End:   lsp.Position{Line: 20, Character: 45},
+						},
+					},
+				},
+				{Type: parser.REF, Name: "stg_customers"}: {
+					{
+						Key: ReferenceKey{Type: parser.REF, Name: "stg_customers"},
+						URI: filepath.Join(testdataRoot, "models/customers.sql"),
+						Range: lsp.Range{
+							Start: lsp.Position{Line: 2, Character: 26},
+							End:   lsp.Position{Line: 2, Character: 39},
+						},
+					},
+				},
+				{Type: parser.REF, Name: "stg_orders"}: {
+					{
+						Key: ReferenceKey{Type: parser.REF, Name: "stg_orders"},
+						URI: filepath.Join(testdataRoot, "models/customers.sql"),
+						Range: lsp.Range{
+							Start: lsp.Position{Line: 8, Character: 26},
+							End:   lsp.Position{Line: 8, Character: 36},
+						},
+					},
+					{
+						Key: ReferenceKey{Type: parser.REF, Name: "stg_orders"},
+						URI: filepath.Join(testdataRoot, "models/orders.sql"),
+						Range: lsp.Range{
+							Start: lsp.Position{Line: 4, Character: 26},
+							End:   lsp.Position{Line: 4, Character: 36},
+						},
+					},
+				},
+				{Type: parser.REF, Name: "stg_payments"}: {
+					{
+						Key: ReferenceKey{Type: parser.REF, Name: "stg_payments"},
+						URI: filepath.Join(testdataRoot, "models/customers.sql"),
+						Range: lsp.Range{
+							Start: lsp.Position{Line: 14, Character: 26},
+							End:   lsp.Position{Line: 14, Character: 38},
+						},
+					},
+					{
+						Key: ReferenceKey{Type: parser.REF, Name: "stg_payments"},
+						URI: filepath.Join(testdataRoot, "models/orders.sql"),
+						Range: lsp.Range{
+							Start: lsp.Position{Line: 10, Character: 26},
+							End:   lsp.Position{Line: 10, Character: 38},
+						},
+					},
+					{
+						Key: ReferenceKey{Type: parser.REF, Name: "stg_payments"},
+						URI: filepath.Join(testdataRoot, "tests/assert_payments_positive.sql"),
+						Range: lsp.Range{
+							Start: lsp.Position{Line: 1, Character: 13},
+							End:   lsp.Position{Line: 1, Character: 25},
+						},
+					},
+				},
+				{Type: parser.VAR, Name: "jaffle_string"}: {
+					{
+						Key: ReferenceKey{Type: parser.VAR, Name: "jaffle_string"},
+						URI: filepath.Join(testdataRoot, "models/customers.sql"),
+						Range: lsp.Range{
+							Start: lsp.Position{Line: 56, Character: 16},
+							End:   lsp.Position{Line: 56, Character: 29},
+						},
+					},
+				},
+			},
+			GenericTestMap: map[Package]map[string]Macro{
+				"jaffle_package": {
+					"not_empty": {
+						Name:        "not_empty",
+						ProjectName: "jaffle_package",
+						Description: "not_empty(model, column_name)",
+						Arguments: []MacroArgument{
+							{Name: "model"},
+							{Name: "column_name"},
+						},
+						URI: filepath.Join(testdataRoot, "dbt_packages/jaffle_package/macros/not_empty.sql"),
+						Range: lsp.Range{
+							Start: lsp.Position{Character: 8},
+							End:   lsp.Position{Character: 37},
+						},
+					},
+				},
+				"jaffle_shop": {
+					"is_positive": {
+						Name:        "is_positive",
+						ProjectName: "jaffle_shop",
+						Description: "is_positive(model, column_name)",
+						Arguments: []MacroArgument{
+							{Name: "model"},
+							{Name: "column_name"},
+						},
+						URI: filepath.Join(testdataRoot, "tests/generic/is_positive.sql"),
+						Range: lsp.Range{
+							Start: lsp.Position{Character: 8},
+							End:   lsp.Position{Character: 39},
+						},
+					},
+				},
+			},
+			SingularTestMap: map[string]string{
+				"assert_payments_positive": filepath.Join(testdataRoot, "tests/assert_payments_positive.sql"),
+			},
+			SemanticDetailMap: map[SemanticKind]map[string]SemanticNode{
+				ExposureKind: {
+					"weekly_kpis": {
+						Name:        "weekly_kpis",
+						Kind:        ExposureKind,
+						ProjectName: "jaffle_shop",
+						Label:       "Weekly KPIs",
+						Type:        "dashboard",
+						URI:         filepath.Join(testdataRoot, "models/semantic.yml"),
+						Range: lsp.Range{
+							Start: lsp.Position{Line: 57, Character: 10},
+							End:   lsp.Position{Line: 57, Character: 21},
+						},
+					},
+				},
+				GroupKind: {
+					"finance": {
+						Name:        "finance",
+						Kind:        GroupKind,
+						ProjectName: "jaffle_shop",
+						URI:         filepath.Join(testdataRoot, "models/semantic.yml"),
+						Range: lsp.Range{
+							Start: lsp.Position{Line: 67, Character: 10},
+							End:   lsp.Position{Line: 67, Character: 17},
+						},
+						Owner: "Finance Team",
+					},
+				},
+				MetricKind: {
+					"completed_orders": {
+						Name:        "completed_orders",
+						Kind:        MetricKind,
+						ProjectName: "jaffle_shop",
+						Label:       "Completed Orders",
+						Type:        "simple",
+						URI:         filepath.Join(testdataRoot, "models/semantic.yml"),
+						Range: lsp.Range{
+							Start: lsp.Position{Line: 38, Character: 10},
+							End:   lsp.Position{Line: 38, Character: 26},
+						},
+					},
+					"revenue": {
+						Name:        "revenue",
+						Kind:        MetricKind,
+						ProjectName: "jaffle_shop",
+						Label:       "Revenue",
+						Type:        "simple",
+						Description: "Total order amount",
+						URI:         filepath.Join(testdataRoot, "models/semantic.yml"),
+						Range: lsp.Range{
+							Start: lsp.Position{Line: 32, Character: 10},
+							End:   lsp.Position{Line: 32, Character: 17},
+						},
+					},
+				},
+				SavedQueryKind: {
+					"revenue_by_status": {
+						Name:        "revenue_by_status",
+						Kind:        SavedQueryKind,
+						ProjectName: "jaffle_shop",
+						Label:       "Revenue by status",
+						URI:         filepath.Join(testdataRoot, "models/semantic.yml"),
+						Range: lsp.Range{
+							Start: lsp.Position{Line: 48, Character: 10},
+							End:   lsp.Position{Line: 48, Character: 27},
+						},
+					},
+				},
+				SemanticModelKind: {
+					"orders": {
+						Name:        "orders",
+						Kind:        SemanticModelKind,
+						ProjectName: "jaffle_shop",
+						Description: "Order fact table",
+						URI:         filepath.Join(testdataRoot, "models/semantic.yml"),
+						Range: lsp.Range{
+							Start: lsp.Position{Line: 3, Character: 10},
+							End:   lsp.Position{Line: 3, Character: 16},
+						},
+						Model: "ref('orders')",
+						Entities: []SemanticElement{
+							{
+								Name: "order",
+								Type: "primary",
+								Range: lsp.Range{
+									Start: lsp.Position{Line: 9, Character: 14},
+									End:   lsp.Position{Line: 9, Character: 14},
+								},
+							},
+							{
+								Name: "customer",
+								Type: "foreign",
+								Range: lsp.Range{
+									Start: lsp.Position{Line: 12, Character: 14},
+									End:   lsp.Position{Line: 12, Character: 14},
+								},
+							},
+						},
+						Dimensions: []SemanticElement{
+							{
+								Name: "order_date",
+								Type: "time",
+								Range: lsp.Range{
+									Start: lsp.Position{Line: 16, Character: 14},
+									End:   lsp.Position{Line: 16, Character: 14},
+								},
+							},
+							{
+								Name: "status",
+								Type: "categorical",
+								Range: lsp.Range{
+									Start: lsp.Position{Line: 20, Character: 14},
+									End:   lsp.Position{Line: 20, Character: 14},
+								},
+							},
+						},
+						Measures: []SemanticElement{
+							{
+								Name:        "order_total",
+								Type:        "sum",
+								Description: "Sum of order amounts",
+								Range: lsp.Range{
+									Start: lsp.Position{Line: 23, Character: 14},
+									End:   lsp.Position{Line: 23, Character: 14},
+								},
+							},
+							{
+								Name: "order_count",
+								Type: "count",
+								Range: lsp.Range{
+									Start: lsp.Position{Line: 27, Character: 14},
+									End:   lsp.Position{Line: 27, Character: 14},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
-		FusionEnabled: false,
+		FusionEnabled:     false,
+		FusionPath:        "",
+		LspClientRootPath: "",
+		WorkspaceFolders:  []string{},
+		projects:          map[string]*DbtContext{},
+		positionEncoding:  UTF16,
 	}
 
 	return expectedState
@@ -368,8 +941,42 @@ func TestRefreshDbtContext(t *testing.T) {
 	state := NewState()
 	state.refreshDbtContext(testdataRoot)
 
-	if !reflect.DeepEqual(state, expectedState) {
-		t.Fatalf("expected %#v,\n\ngot %#v", expectedState, state)
+	if !reflect.DeepEqual(&state, expectedState) {
+		t.Fatalf("expected %#v,\n\ngot %#v", expectedState, &state)
+	}
+}
+
+func TestConcurrentRequests(t *testing.T) {
+	testdataRoot, err := testutils.GetTestdataPath("jaffle_shop_duckdb")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	state := NewState()
+	state.SetLspClientRootPath(testdataRoot)
+	uri := "file://" + filepath.Join(testdataRoot, "models/orders.sql")
+	state.OpenDocument(uri, "select * from {{ ref('customers') }}")
+
+	// run with -race: edits and saves lock the state while requests read it
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			state.UpdateDocument(uri, "select * from {{ ref('stg_orders') }}")
+			state.SaveDocument(uri)
+		}()
+		go func() {
+			defer wg.Done()
+			state.Hover(1, uri, lsp.Position{Line: 0, Character: 24})
+			state.TextDocumentCompletion(2, uri, lsp.Position{Line: 0, Character: 22})
+			state.Diagnostics(uri)
+		}()
+	}
+	wg.Wait()
+
+	if text := state.Documents[uri].Text; text != "select * from {{ ref('stg_orders') }}" {
+		t.Errorf("unexpected document text %q", text)
 	}
 }
 
@@ -384,3 +991,11 @@ func BenchmarkRefreshDbtContext(b *testing.B) {
 		state.refreshDbtContext(testdataRoot)
 	}
 }
+
+func columnsNamed(names ...string) []Column {
+	columns := []Column{}
+	for _, name := range names {
+		columns = append(columns, Column{Name: name})
+	}
+	return columns
+}
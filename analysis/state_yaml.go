@@ -0,0 +1,284 @@
+package analysis
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/j-clemons/dbt-language-server/analysis/parser"
+	"github.com/j-clemons/dbt-language-server/lsp"
+	"github.com/j-clemons/dbt-language-server/lsp/completionKind"
+	diagnosticseverity "github.com/j-clemons/dbt-language-server/lsp/diagnosticSeverity"
+)
+
+// builtinGenericTests are the generic tests that ship with dbt.
+var builtinGenericTests = []string{"unique", "not_null", "accepted_values", "relationships"}
+
+// onYamlValue reports whether position is within the value on its line.
+func onYamlValue(position lsp.Position, ctx yamlContext) bool {
+	return position.Character >= ctx.ValueStart
+}
+
+func (s *State) modelNamesOfKind(seeds bool) []string {
+	names := []string{}
+	for name, model := range s.DbtContext.ModelDetailMap {
+		if !model.Snapshot && (filepath.Ext(model.URI) == ".csv") == seeds {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// genericTestItems completes entries of `data_tests:` and `tests:` lists.
+func (s *State) genericTestItems() []lsp.CompletionItem {
+	items := []lsp.CompletionItem{}
+	for _, test := range builtinGenericTests {
+		items = append(items, lsp.CompletionItem{
+			Label:      test,
+			Detail:     "dbt",
+			Kind:       completionKind.Function,
+			InsertText: test,
+			SortText:   "0" + test,
+		})
+	}
+
+	projectName := s.DbtContext.ProjectYaml.ProjectName.Value
+	testItems := []lsp.CompletionItem{}
+	for pkg, tests := range s.DbtContext.GenericTestMap {
+		for name, test := range tests {
+			label := name
+			if string(pkg) != projectName {
+				label = string(pkg) + "." + name
+			}
+			testItems = append(testItems, lsp.CompletionItem{
+				Label:         label,
+				Detail:        fmt.Sprintf("Project: %s", pkg),
+				Documentation: test.Description,
+				Kind:          completionKind.Function,
+				InsertText:    label,
+				SortText:      "1" + label,
+			})
+		}
+	}
+	sort.Slice(testItems, func(i, j int) bool { return testItems[i].Label < testItems[j].Label })
+	return append(items, testItems...)
+}
+
+// yamlTestName returns the generic test a `data_tests:` entry names, either
+// as `- unique` or as the key of `- accepted_values:` with arguments.
+func yamlTestName(ctx yamlContext, position lsp.Position) (string, bool) {
+	if len(ctx.Keys) == 0 {
+		return "", false
+	}
+	if last := ctx.Keys[len(ctx.Keys)-1]; last != "data_tests" && last != "tests" {
+		return "", false
+	}
+	if ctx.Key == "" {
+		return unquote(ctx.Value), true
+	}
+	return ctx.Key, !onYamlValue(position, ctx)
+}
+
+func (s *State) yamlColumnItems(r relation) []lsp.CompletionItem {
+	items := []lsp.CompletionItem{}
+	for i, c := range s.relationColumns(r) {
+		items = append(items, lsp.CompletionItem{
+			Label:         c.Name,
+			Detail:        c.Type,
+			Documentation: c.Comment,
+			Kind:          completionKind.Field,
+			InsertText:    c.Name,
+			SortText:      fmt.Sprintf("%04d", i),
+		})
+	}
+	return items
+}
+
+// yamlCompletionItems completes names in properties files based on where
+// the cursor is nested.
+func (s *State) yamlCompletionItems(doc Document, position lsp.Position) ([]lsp.CompletionItem, bool) {
+	if doc.Yaml.Project {
+		return s.projectCompletionItems(doc, position)
+	}
+	if items, ok := s.semanticYamlItems(doc, position); ok {
+		return items, true
+	}
+	ctx, ok := doc.Yaml.contextAt(position.Line)
+	if !ok || !onYamlValue(position, ctx) {
+		return nil, false
+	}
+
+	switch {
+	case ctx.Key == "name" && ctx.path() == "models":
+		items := []lsp.CompletionItem{}
+		for _, name := range s.modelNamesOfKind(false) {
+			model := s.DbtContext.ModelDetailMap[name]
+			items = append(items, lsp.CompletionItem{
+				Label:         name,
+				Detail:        fmt.Sprintf("Project: %s", model.ProjectName),
+				Documentation: model.Description,
+				Kind:          completionKind.Reference,
+				InsertText:    name,
+				SortText:      name,
+			})
+		}
+		return items, true
+	case ctx.Key == "name" && ctx.path() == "seeds":
+		items := []lsp.CompletionItem{}
+		for _, name := range s.modelNamesOfKind(true) {
+			items = append(items, lsp.CompletionItem{
+				Label:      name,
+				Detail:     "Seed File",
+				Kind:       completionKind.File,
+				InsertText: name,
+				SortText:   name,
+			})
+		}
+		return items, true
+	case ctx.Key == "name" && ctx.path() == "models.columns":
+		return s.yamlColumnItems(relation{Type: parser.REF, Name: ctx.name(0)}), true
+	case ctx.Key == "name" && ctx.path() == "seeds.columns":
+		return s.yamlColumnItems(relation{Type: parser.REF, Name: ctx.name(0)}), true
+	case ctx.Key == "name" && ctx.path() == "sources.tables.columns":
+		return s.yamlColumnItems(relation{Type: parser.SOURCE_TABLE, Source: ctx.name(0), Name: ctx.name(1)}), true
+	case ctx.Key == "" && len(ctx.Keys) > 0 && (ctx.Keys[len(ctx.Keys)-1] == "data_tests" || ctx.Keys[len(ctx.Keys)-1] == "tests"):
+		return s.genericTestItems(), true
+	}
+
+	return nil, false
+}
+
+// modelColumnRange finds where a model's SQL names an output column.
+func (s *State) modelColumnRange(model ModelDetails, column string) (lsp.Range, bool) {
+	text, err := s.documentText(model.URI)
+	if err != nil {
+		return lsp.Range{}, false
+	}
+	query := parser.ParseQuery(parser.Parse(text, s.DbtContext.Dialect).CreateTokenIndex().Tokens())
+	if query == nil {
+		return lsp.Range{}, false
+	}
+	defs, _ := lineageResolver{s: s}.definitions(query, lineageScope{}, 0)
+	for _, def := range defs {
+		if strings.EqualFold(def.name, column) {
+			return definitionRange(def), true
+		}
+	}
+	return lsp.Range{}, false
+}
+
+// yamlDefinition jumps from a `name:` entry to the file it documents, or
+// from a test to its `{% test %}` block.
+func (s *State) yamlDefinition(doc Document, position lsp.Position) (lsp.Location, bool) {
+	ctx, ok := doc.Yaml.contextAt(position.Line)
+	if !ok {
+		return lsp.Location{}, false
+	}
+	if name, ok := yamlTestName(ctx, position); ok {
+		if test, found := s.genericTest(name); found {
+			return lsp.Location{URI: "file://" + test.URI, Range: test.Range}, true
+		}
+		return lsp.Location{}, false
+	}
+	if ctx.Key != "name" || !onYamlValue(position, ctx) {
+		return lsp.Location{}, false
+	}
+	name := unquote(ctx.Value)
+
+	switch ctx.path() {
+	case "models", "seeds":
+		if model, ok := s.modelByName(name); ok {
+			return lsp.Location{URI: "file://" + model.URI}, true
+		}
+	case "models.columns":
+		model, ok := s.modelByName(ctx.name(0))
+		if !ok {
+			break
+		}
+		location := lsp.Location{URI: "file://" + model.URI}
+		if rng, found := s.modelColumnRange(model, name); found {
+			location.Range = rng
+		}
+		return location, true
+	case "macros":
+		for _, macros := range s.DbtContext.MacroDetailMap {
+			if macro, ok := macros[name]; ok {
+				return lsp.Location{URI: "file://" + macro.URI, Range: macro.Range}, true
+			}
+		}
+	}
+	return lsp.Location{}, false
+}
+
+// yamlHover describes the model or seed a `name:` entry documents.
+func (s *State) yamlHover(doc Document, position lsp.Position) (string, bool) {
+	if doc.Yaml.Project {
+		return s.projectHover(doc, position)
+	}
+	ctx, ok := doc.Yaml.contextAt(position.Line)
+	if !ok || ctx.Key != "name" || !onYamlValue(position, ctx) {
+		return "", false
+	}
+	name := unquote(ctx.Value)
+
+	switch ctx.path() {
+	case "models", "seeds":
+		model, ok := s.modelByName(name)
+		if !ok {
+			return "", false
+		}
+		columns := model.Columns
+		if len(columns) == 0 {
+			columns = model.InferredColumns
+		}
+		description := model.Description
+		if path, err := filepath.Rel(s.DbtContext.ProjectRoot, model.URI); err == nil && s.DbtContext.ProjectRoot != "" {
+			description = strings.TrimSpace(description + "\n\n`" + path + "`")
+		}
+		return withColumnTable(description, columns), true
+	case "models.columns":
+		model, ok := s.modelByName(ctx.name(0))
+		if !ok {
+			return "", false
+		}
+		for _, c := range model.Columns {
+			if strings.EqualFold(c.Name, name) {
+				return strings.TrimSpace(fmt.Sprintf("`%s` %s\n\n%s", c.Name, c.Type, c.Comment)), true
+			}
+		}
+	}
+	return "", false
+}
+
+// yamlDiagnostics reports properties entries for models that don't exist,
+// or dbt_project.yml keys that don't match the schema.
+func (s *State) yamlDiagnostics(doc Document) []lsp.Diagnostic {
+	if doc.Yaml.Project {
+		return s.projectDiagnostics(doc)
+	}
+	diagnostics := []lsp.Diagnostic{}
+	for _, props := range doc.Yaml.Properties.Models {
+		if props.Name.Value == "" {
+			continue
+		}
+		if _, ok := s.modelByName(props.Name.Value); ok {
+			continue
+		}
+		diagnostics = append(diagnostics, lsp.Diagnostic{
+			Range: lsp.Range{
+				Start: props.Name.Position,
+				End: lsp.Position{
+					Line:      props.Name.Position.Line,
+					Character: props.Name.Position.Character + len(props.Name.Value),
+				},
+			},
+			Message:  fmt.Sprintf("Model '%s' was not found in the project or installed packages", props.Name.Value),
+			Severity: diagnosticseverity.Warning,
+			Code:     "unresolved-model",
+			Source:   diagnosticSource,
+		})
+	}
+	return diagnostics
+}
@@ -0,0 +1,161 @@
+package analysis
+
+import (
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/j-clemons/dbt-language-server/lsp"
+	diagnosticseverity "github.com/j-clemons/dbt-language-server/lsp/diagnosticSeverity"
+	"github.com/j-clemons/dbt-language-server/testutils"
+)
+
+const yamlTestProperties = `version: 2
+
+models:
+  - name: stg_orders
+    columns:
+      - name: status
+        data_tests:
+          - 
+  - name: stg_
+  - name: retired_model
+
+seeds:
+  - name: raw_orders
+    columns:
+      - name: 
+`
+
+func yamlTestState(t *testing.T) (*State, string) {
+	testdataRoot, err := testutils.GetTestdataPath("jaffle_shop_duckdb")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	state := NewState()
+	state.refreshDbtContext(testdataRoot)
+	state.Documents = map[string]Document{}
+
+	uri := "file://" + filepath.Join(testdataRoot, "models/staging/extra.yml")
+	state.parseDocument(uri, yamlTestProperties)
+	return &state, testdataRoot
+}
+
+func completionLabels(items []lsp.CompletionItem) []string {
+	labels := []string{}
+	for _, item := range items {
+		labels = append(labels, item.Label)
+	}
+	return labels
+}
+
+func TestYamlCompletion(t *testing.T) {
+	state, testdataRoot := yamlTestState(t)
+	uri := "file://" + filepath.Join(testdataRoot, "models/staging/extra.yml")
+
+	tests := []struct {
+		name     string
+		position lsp.Position
+		expected []string
+	}{
+		{
+			name:     "model names",
+			position: lsp.Position{Line: 8, Character: 14},
+			expected: []string{"customers", "orders", "stg_customer_status", "stg_customers", "stg_orders", "stg_payments"},
+		},
+		{
+			name:     "model columns",
+			position: lsp.Position{Line: 5, Character: 14},
+			expected: []string{"order_id", "customer_id", "order_date", "status"},
+		},
+		{
+			name:     "generic tests",
+			position: lsp.Position{Line: 7, Character: 12},
+			expected: []string{"unique", "not_null", "accepted_values", "relationships", "is_positive", "jaffle_package.not_empty"},
+		},
+		{
+			name:     "seed columns",
+			position: lsp.Position{Line: 14, Character: 14},
+			expected: []string{"id", "user_id", "order_date", "status"},
+		},
+		{
+			name:     "keys",
+			position: lsp.Position{Line: 2, Character: 3},
+			expected: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			response := state.TextDocumentCompletion(1, uri, tt.position)
+			if actual := completionLabels(response.Result); !reflect.DeepEqual(actual, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestYamlDefinitionAndHover(t *testing.T) {
+	state, testdataRoot := yamlTestState(t)
+	uri := "file://" + filepath.Join(testdataRoot, "models/schema.yml")
+	text, err := state.documentText(filepath.Join(testdataRoot, "models/schema.yml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	state.parseDocument(uri, text)
+
+	definition := state.Definition(1, uri, lsp.Position{Line: 3, Character: 12})
+	expected := lsp.Location{URI: "file://" + filepath.Join(testdataRoot, "models/customers.sql")}
+	if definition.Result != expected {
+		t.Errorf("expected %v, got %v", expected, definition.Result)
+	}
+
+	// columns resolve to where the model's SQL names them
+	definition = state.Definition(2, uri, lsp.Position{Line: 25, Character: 16})
+	expected.Range = lsp.Range{
+		Start: lsp.Position{Line: 63, Character: 24},
+		End:   lsp.Position{Line: 63, Character: 40},
+	}
+	if definition.Result != expected {
+		t.Errorf("expected %v, got %v", expected, definition.Result)
+	}
+
+	hover := state.Hover(3, uri, lsp.Position{Line: 3, Character: 12})
+	for _, want := range []string{"basic information about a customer", "`models/customers.sql`", "| customer_lifetime_value |"} {
+		if !strings.Contains(hover.Result.Contents, want) {
+			t.Errorf("expected hover to contain %q, got %q", want, hover.Result.Contents)
+		}
+	}
+
+	// keys aren't SQL functions
+	if hover := state.Hover(4, uri, lsp.Position{Line: 4, Character: 6}); hover.Result.Contents != "" {
+		t.Errorf("expected no hover on a key, got %q", hover.Result.Contents)
+	}
+}
+
+func TestYamlDiagnostics(t *testing.T) {
+	state, testdataRoot := yamlTestState(t)
+	uri := "file://" + filepath.Join(testdataRoot, "models/staging/extra.yml")
+
+	expected := []lsp.Diagnostic{
+		{
+			Range:    lsp.Range{Start: lsp.Position{Line: 8, Character: 10}, End: lsp.Position{Line: 8, Character: 14}},
+			Message:  "Model 'stg_' was not found in the project or installed packages",
+			Severity: diagnosticseverity.Warning,
+			Code:     "unresolved-model",
+			Source:   diagnosticSource,
+		},
+		{
+			Range:    lsp.Range{Start: lsp.Position{Line: 9, Character: 10}, End: lsp.Position{Line: 9, Character: 23}},
+			Message:  "Model 'retired_model' was not found in the project or installed packages",
+			Severity: diagnosticseverity.Warning,
+			Code:     "unresolved-model",
+			Source:   diagnosticSource,
+		},
+	}
+	if actual := state.Diagnostics(uri); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected %v,\n\ngot %v", expected, actual)
+	}
+}
@@ -0,0 +1,175 @@
+package analysis
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// SetWorkspaceFolders sets the folders the client opened.
+func (s *State) SetWorkspaceFolders(folders []string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.WorkspaceFolders = slices.Clone(folders)
+}
+
+// UpdateWorkspaceFolders applies a change to the workspace folders. The
+// projects in a removed folder are forgotten unless another folder holds them.
+func (s *State) UpdateWorkspaceFolders(added, removed []string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	folders := []string{}
+	for _, folder := range s.WorkspaceFolders {
+		if !slices.Contains(removed, folder) && !slices.Contains(added, folder) {
+			folders = append(folders, folder)
+		}
+	}
+	s.WorkspaceFolders = append(folders, added...)
+
+	for root := range s.projects {
+		inRemoved := slices.ContainsFunc(removed, func(folder string) bool { return withinDir(root, folder) })
+		inFolder := slices.ContainsFunc(s.WorkspaceFolders, func(folder string) bool { return withinDir(root, folder) })
+		if inRemoved && !inFolder {
+			delete(s.projects, root)
+		}
+	}
+}
+
+// withinDir reports whether path is dir or inside it.
+func withinDir(path, dir string) bool {
+	return path == dir || strings.HasPrefix(path, strings.TrimSuffix(dir, string(filepath.Separator))+string(filepath.Separator))
+}
+
+// scoped is a view of the state that answers with ctx. It shares the
+// documents and projects of s, and is only used while s is locked.
+func (s *State) scoped(ctx DbtContext) *State {
+	return &State{
+		Documents:         s.Documents,
+		DbtContext:        ctx,
+		FusionEnabled:     s.FusionEnabled,
+		FusionPath:        s.FusionPath,
+		LspClientRootPath: s.workspaceFolder(ctx.ProjectRoot),
+		WorkspaceFolders:  s.WorkspaceFolders,
+		projects:          s.projects,
+		lifecycle:         s.lifecycle,
+		positionEncoding:  s.positionEncoding,
+	}
+}
+
+// project returns a view of the state for the dbt project the document at
+// uri is in: the loaded project with the deepest root above it. Documents
+// outside every loaded project use s.
+func (s *State) project(uri string) *State {
+	root := s.loadedProjectRoot(strings.TrimPrefix(uri, "file://"))
+	if root == "" {
+		return s
+	}
+	return s.scoped(*s.projects[root])
+}
+
+// loadedProjectRoot is the deepest root of a loaded project above path, or
+// "" when path is outside them.
+func (s *State) loadedProjectRoot(path string) string {
+	best := ""
+	for root := range s.projects {
+		if withinDir(path, root) && len(root) > len(best) {
+			best = root
+		}
+	}
+	return best
+}
+
+// inProject reports whether the file at path belongs to this project
+// rather than another dbt project, whose documents may also be open. Files
+// outside every loaded project belong to the default context.
+func (s *State) inProject(path string) bool {
+	owner := s.loadedProjectRoot(path)
+	if _, loaded := s.projects[s.DbtContext.ProjectRoot]; loaded {
+		return owner == s.DbtContext.ProjectRoot
+	}
+	return owner == ""
+}
+
+// projectStates returns a view of every loaded project, and s if its
+// context isn't one of them.
+func (s *State) projectStates() []*State {
+	roots := make([]string, 0, len(s.projects))
+	for root := range s.projects {
+		roots = append(roots, root)
+	}
+	slices.Sort(roots)
+
+	states := []*State{}
+	if _, ok := s.projects[s.DbtContext.ProjectRoot]; !ok {
+		states = append(states, s)
+	}
+	for _, root := range roots {
+		states = append(states, s.scoped(*s.projects[root]))
+	}
+	return states
+}
+
+// workspaceFolder is the deepest workspace folder holding path, where the
+// profiles are looked up from. It falls back to the client's root path.
+func (s *State) workspaceFolder(path string) string {
+	best := ""
+	for _, folder := range s.WorkspaceFolders {
+		if withinDir(path, folder) && len(folder) > len(best) {
+			best = folder
+		}
+	}
+	if best == "" {
+		return s.LspClientRootPath
+	}
+	return best
+}
+
+// findProjectRoot returns the directory of the nearest dbt_project.yml above
+// path. Installed packages belong to the project that installed them, so
+// their dbt_project.yml is skipped.
+func (s *State) findProjectRoot(path string) string {
+	if !filepath.IsAbs(path) {
+		return ""
+	}
+	for dir := filepath.Dir(path); ; dir = filepath.Dir(dir) {
+		if _, err := os.Stat(filepath.Join(dir, "dbt_project.yml")); err == nil && !s.isInstalledPackage(dir) {
+			return dir
+		}
+		if filepath.Dir(dir) == dir {
+			return ""
+		}
+	}
+}
+
+func (s *State) isInstalledPackage(dir string) bool {
+	parent := filepath.Dir(dir)
+	if filepath.Base(parent) == "dbt_packages" {
+		return true
+	}
+	for _, ctx := range s.projects {
+		if installPath := ctx.ProjectYaml.PackagesInstallPath.Value; installPath != "" && parent == filepath.Join(ctx.ProjectRoot, installPath) {
+			return true
+		}
+	}
+	return false
+}
+
+// refreshProject reloads the context of the dbt project the document at uri
+// is in. Documents outside a dbt project use the one found from the
+// client's root path.
+func (s *State) refreshProject(uri string) {
+	root := s.findProjectRoot(strings.TrimPrefix(uri, "file://"))
+	if root == "" {
+		s.refreshDbtContext(s.LspClientRootPath)
+		return
+	}
+
+	view := s.scoped(DbtContext{})
+	view.loadDbtContext(root, s.workspaceFolder(root))
+	s.projects[root] = &view.DbtContext
+	if s.DbtContext.ProjectRoot == "" || s.DbtContext.ProjectRoot == root {
+		s.DbtContext = view.DbtContext
+	}
+}
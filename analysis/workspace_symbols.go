@@ -0,0 +1,199 @@
+package analysis
+
+import (
+	"context"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/j-clemons/dbt-language-server/lsp"
+	"github.com/j-clemons/dbt-language-server/lsp/symbolKind"
+)
+
+// fuzzyScore matches query as a case-insensitive subsequence of candidate.
+// Consecutive characters and matches at the start of a word score higher.
+func fuzzyScore(query string, candidate string) (int, bool) {
+	if query == "" {
+		return 0, true
+	}
+
+	q := strings.ToLower(query)
+	c := strings.ToLower(candidate)
+
+	score := 0
+	qi := 0
+	prevMatch := -2
+	for ci := 0; ci < len(c) && qi < len(q); ci++ {
+		if c[ci] != q[qi] {
+			continue
+		}
+		score++
+		if ci == prevMatch+1 {
+			score += 5
+		}
+		if ci == 0 || c[ci-1] == '_' || c[ci-1] == '.' {
+			score += 3
+		}
+		prevMatch = ci
+		qi++
+	}
+
+	if qi < len(q) {
+		return 0, false
+	}
+
+	if strings.Contains(c, q) {
+		score += 10
+	}
+	score -= (len(c) - len(q)) / 4
+
+	return score, true
+}
+
+type scoredSymbol struct {
+	symbol lsp.SymbolInformation
+	score  int
+}
+
+func (s *State) workspaceSymbols() []lsp.SymbolInformation {
+	symbols := []lsp.SymbolInformation{}
+
+	for name, model := range s.DbtContext.ModelDetailMap {
+		kind := symbolKind.Class
+		if filepath.Ext(model.URI) == ".csv" {
+			kind = symbolKind.File
+		}
+		symbols = append(symbols, lsp.SymbolInformation{
+			Name:          name,
+			Kind:          kind,
+			Location:      lsp.Location{URI: "file://" + model.URI},
+			ContainerName: model.ProjectName,
+		})
+	}
+
+	for _, source := range s.DbtContext.SourceDetailMap {
+		symbols = append(symbols, lsp.SymbolInformation{
+			Name:          source.Name,
+			Kind:          symbolKind.Namespace,
+			Location:      lsp.Location{URI: "file://" + source.URI, Range: source.Range},
+			ContainerName: source.ProjectName,
+		})
+		for _, table := range source.Tables {
+			symbols = append(symbols, lsp.SymbolInformation{
+				Name:          source.Name + "." + table.Name,
+				Kind:          symbolKind.Struct,
+				Location:      lsp.Location{URI: "file://" + table.URI, Range: table.Range},
+				ContainerName: source.ProjectName,
+			})
+		}
+	}
+
+	for _, macroMap := range s.DbtContext.MacroDetailMap {
+		for _, macro := range macroMap {
+			symbols = append(symbols, lsp.SymbolInformation{
+				Name:          macro.Name,
+				Kind:          symbolKind.Function,
+				Location:      lsp.Location{URI: "file://" + macro.URI, Range: macro.Range},
+				ContainerName: string(macro.ProjectName),
+			})
+		}
+	}
+
+	for _, tests := range s.DbtContext.GenericTestMap {
+		for _, test := range tests {
+			symbols = append(symbols, lsp.SymbolInformation{
+				Name:          test.Name,
+				Kind:          symbolKind.Function,
+				Location:      lsp.Location{URI: "file://" + test.URI, Range: test.Range},
+				ContainerName: string(test.ProjectName),
+			})
+		}
+	}
+
+	for name, path := range s.DbtContext.SingularTestMap {
+		symbols = append(symbols, lsp.SymbolInformation{
+			Name:          name,
+			Kind:          symbolKind.File,
+			Location:      lsp.Location{URI: "file://" + path},
+			ContainerName: s.DbtContext.ProjectYaml.ProjectName.Value,
+		})
+	}
+
+	for _, variable := range s.DbtContext.VariableDetailMap {
+		symbols = append(symbols, lsp.SymbolInformation{
+			Name:          variable.Name,
+			Kind:          symbolKind.Variable,
+			Location:      lsp.Location{URI: "file://" + variable.URI, Range: variable.Range},
+			ContainerName: s.DbtContext.ProjectYaml.ProjectName.Value,
+		})
+	}
+
+	for _, docsMap := range s.DbtContext.DocsDetailMap {
+		for _, d := range docsMap {
+			symbols = append(symbols, lsp.SymbolInformation{
+				Name:          d.Name,
+				Kind:          symbolKind.String,
+				Location:      lsp.Location{URI: "file://" + d.URI, Range: d.Range},
+				ContainerName: string(d.ProjectName),
+			})
+		}
+	}
+
+	return symbols
+}
+
+func (s *State) WorkspaceSymbol(ctx context.Context, id lsp.RequestID, query string) lsp.WorkspaceSymbolResponse {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	response := lsp.WorkspaceSymbolResponse{
+		Response: lsp.Response{
+			RPC: "2.0",
+			ID:  id,
+		},
+		Result: []lsp.SymbolInformation{},
+	}
+
+	// packages shared by several projects are listed once
+	symbols := []lsp.SymbolInformation{}
+	seen := map[lsp.SymbolInformation]bool{}
+	for _, project := range s.projectStates() {
+		if ctx.Err() != nil {
+			return response
+		}
+		for _, symbol := range project.workspaceSymbols() {
+			if !seen[symbol] {
+				seen[symbol] = true
+				symbols = append(symbols, symbol)
+			}
+		}
+	}
+
+	matches := []scoredSymbol{}
+	for _, symbol := range symbols {
+		score, ok := fuzzyScore(query, symbol.Name)
+		// allow package qualified queries such as dbt_utils.star
+		if qualifiedScore, qualifiedOk := fuzzyScore(query, symbol.ContainerName+"."+symbol.Name); qualifiedOk && (!ok || qualifiedScore > score) {
+			score, ok = qualifiedScore, true
+		}
+		if ok {
+			matches = append(matches, scoredSymbol{symbol: symbol, score: score})
+		}
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].score != matches[j].score {
+			return matches[i].score > matches[j].score
+		}
+		if matches[i].symbol.Name != matches[j].symbol.Name {
+			return matches[i].symbol.Name < matches[j].symbol.Name
+		}
+		return matches[i].symbol.ContainerName < matches[j].symbol.ContainerName
+	})
+
+	for _, m := range matches {
+		response.Result = append(response.Result, m.symbol)
+	}
+
+	return response
+}
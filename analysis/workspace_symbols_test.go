@@ -0,0 +1,85 @@
+package analysis
+
+import (
+	"context"
+	"testing"
+
+	"github.com/j-clemons/dbt-language-server/lsp/symbolKind"
+	"github.com/j-clemons/dbt-language-server/testutils"
+)
+
+func TestFuzzyScore(t *testing.T) {
+	tests := []struct {
+		query     string
+		candidate string
+		matches   bool
+	}{
+		{"", "customers", true},
+		{"cust", "customers", true},
+		{"stgord", "stg_orders", true},
+		{"STG_ORD", "stg_orders", true},
+		{"ordz", "stg_orders", false},
+		{"sro", "orders", false},
+	}
+
+	for _, tt := range tests {
+		_, ok := fuzzyScore(tt.query, tt.candidate)
+		if ok != tt.matches {
+			t.Errorf("fuzzyScore(%q, %q) matched=%v, want %v", tt.query, tt.candidate, ok, tt.matches)
+		}
+	}
+
+	exact, _ := fuzzyScore("orders", "orders")
+	scattered, _ := fuzzyScore("orders", "o_r_d_e_r_s_history")
+	if exact <= scattered {
+		t.Errorf("expected exact match to outscore scattered match, got %d <= %d", exact, scattered)
+	}
+}
+
+func TestWorkspaceSymbol(t *testing.T) {
+	testdataRoot, err := testutils.GetTestdataPath("jaffle_shop_duckdb")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	state := NewState()
+	state.refreshDbtContext(testdataRoot)
+
+	tests := []struct {
+		query         string
+		expectedName  string
+		expectedKind  int
+		expectedGroup string
+	}{
+		{"stg_orders", "stg_orders", symbolKind.Class, "jaffle_shop"},
+		{"raw_pay", "raw_payments", symbolKind.File, "jaffle_shop"},
+		{"stripe.pay", "stripe.payments", symbolKind.Struct, "jaffle_shop"},
+		{"jaffle_package.add", "add_values", symbolKind.Function, "jaffle_package"},
+		{"jaffle_num", "jaffle_number", symbolKind.Variable, "jaffle_shop"},
+		{"orders_status", "orders_status", symbolKind.String, "jaffle_shop"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			result := state.WorkspaceSymbol(context.Background(), 1, tt.query).Result
+			if len(result) == 0 {
+				t.Fatalf("expected results for %q", tt.query)
+			}
+
+			top := result[0]
+			if top.Name != tt.expectedName || top.Kind != tt.expectedKind || top.ContainerName != tt.expectedGroup {
+				t.Fatalf("expected %s (kind %d, container %s), got %+v", tt.expectedName, tt.expectedKind, tt.expectedGroup, top)
+			}
+		})
+	}
+
+	if result := state.WorkspaceSymbol(context.Background(), 1, "zzzz").Result; len(result) != 0 {
+		t.Fatalf("expected no results, got %v", result)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if result := state.WorkspaceSymbol(ctx, 1, "stg_orders").Result; len(result) != 0 {
+		t.Fatalf("expected a cancelled search to stop early, got %v", result)
+	}
+}
@@ -0,0 +1,118 @@
+package analysis
+
+import (
+	"context"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/j-clemons/dbt-language-server/lsp"
+	"github.com/j-clemons/dbt-language-server/testutils"
+)
+
+func TestDocumentsRouteToTheirProject(t *testing.T) {
+	root, err := testutils.GetTestdataPath("monorepo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	state := NewState()
+	state.SetLspClientRootPath(root)
+	state.SetWorkspaceFolders([]string{root})
+
+	finance := "file://" + filepath.Join(root, "finance/models/report.sql")
+	marketing := "file://" + filepath.Join(root, "marketing/models/report.sql")
+	calendar := "file://" + filepath.Join(root, "finance/dbt_packages/shared/models/calendar.sql")
+	state.OpenDocument(finance, "select * from {{ ref('revenue') }}")
+	state.OpenDocument(marketing, "select * from {{ ref('revenue') }}")
+	state.OpenDocument(calendar, "select 1")
+
+	tests := []struct {
+		uri     string
+		project string
+	}{
+		{finance, "finance"},
+		{marketing, "marketing"},
+		{calendar, "finance"},
+	}
+	for _, tt := range tests {
+		if actual := state.ProjectName(tt.uri); actual != tt.project {
+			t.Errorf("%s: expected project %s, got %s", tt.uri, tt.project, actual)
+		}
+	}
+	if len(state.projects) != 2 {
+		t.Errorf("expected a context for each project, got %d", len(state.projects))
+	}
+
+	unresolved := func(uri string) int {
+		count := 0
+		for _, d := range state.Diagnostics(uri) {
+			if d.Code == "unresolved-ref" {
+				count++
+			}
+		}
+		return count
+	}
+	if count := unresolved(finance); count != 0 {
+		t.Errorf("expected revenue to resolve in finance, got %d unresolved refs", count)
+	}
+	if count := unresolved(marketing); count != 1 {
+		t.Errorf("expected revenue not to resolve in marketing, got %d unresolved refs", count)
+	}
+
+	names := []string{}
+	for _, symbol := range state.WorkspaceSymbol(context.Background(), 1, "").Result {
+		names = append(names, symbol.Name)
+	}
+	for _, name := range []string{"revenue", "campaigns", "calendar"} {
+		if !slices.Contains(names, name) {
+			t.Errorf("expected %s among the workspace symbols, got %v", name, names)
+		}
+	}
+}
+
+func TestRemovingWorkspaceFolderForgetsProjects(t *testing.T) {
+	root, err := testutils.GetTestdataPath("monorepo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	financeRoot := filepath.Join(root, "finance")
+	marketingRoot := filepath.Join(root, "marketing")
+	state := NewState()
+	state.SetWorkspaceFolders([]string{financeRoot, marketingRoot})
+
+	state.OpenDocument("file://"+filepath.Join(financeRoot, "models/revenue.sql"), "select 1")
+	state.OpenDocument("file://"+filepath.Join(marketingRoot, "models/campaigns.sql"), "select 1")
+
+	state.UpdateWorkspaceFolders(nil, []string{marketingRoot})
+	if _, ok := state.projects[marketingRoot]; ok {
+		t.Error("expected the marketing project to be forgotten")
+	}
+	if _, ok := state.projects[financeRoot]; !ok {
+		t.Error("expected the finance project to be kept")
+	}
+	if expected := []string{financeRoot}; !slices.Equal(state.WorkspaceFolders, expected) {
+		t.Errorf("expected %v, got %v", expected, state.WorkspaceFolders)
+	}
+}
+
+func TestReferencesStayInTheirProject(t *testing.T) {
+	root, err := testutils.GetTestdataPath("monorepo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	state := NewState()
+	state.SetWorkspaceFolders([]string{root})
+
+	finance := "file://" + filepath.Join(root, "finance/models/report.sql")
+	marketing := "file://" + filepath.Join(root, "marketing/models/report.sql")
+	state.OpenDocument(finance, "select * from {{ ref('revenue') }}")
+	state.OpenDocument(marketing, "select * from {{ ref('revenue') }}")
+
+	uris := []string{}
+	for _, location := range state.References(context.Background(), 1, finance, lsp.Position{Line: 0, Character: 22}, false).Result {
+		uris = append(uris, location.URI)
+	}
+	if expected := []string{finance}; !slices.Equal(uris, expected) {
+		t.Errorf("expected %v, got %v", expected, uris)
+	}
+}
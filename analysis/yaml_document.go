@@ -0,0 +1,193 @@
+package analysis
+
+import (
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// yamlDocument is the YAML view of an open properties file. Lines are kept
+// so the cursor context can be read from indentation, which still works
+// while the file is being typed and doesn't parse.
+type yamlDocument struct {
+	Lines      []string
+	Properties PropertiesYaml
+	// Project is set for dbt_project.yml, which is checked against the
+	// project schema rather than read as a properties file.
+	Project bool
+}
+
+func isYamlURI(uri string) bool {
+	ext := filepath.Ext(uri)
+	return ext == ".yml" || ext == ".yaml"
+}
+
+func newYamlDocument(text string) *yamlDocument {
+	doc := &yamlDocument{Lines: strings.Split(text, "\n")}
+	if err := yaml.Unmarshal([]byte(text), &doc.Properties); err != nil {
+		doc.Properties = PropertiesYaml{}
+	}
+	return doc
+}
+
+// yamlLine is a `key: value`, `- key: value` or `- value` line.
+type yamlLine struct {
+	Dash       bool
+	DashIndent int
+	// KeyIndent is the column of the key, or of the value for `- value`.
+	KeyIndent  int
+	Key        string
+	Value      string
+	ValueStart int
+}
+
+var (
+	yamlKeyRegex  = regexp.MustCompile(`^(\s*)(-\s+)?([\w+.-]+):(\s*)(.*)$`)
+	yamlItemRegex = regexp.MustCompile(`^(\s*)-(\s+|$)(.*)$`)
+)
+
+func parseYamlLine(line string) (yamlLine, bool) {
+	trimmed := strings.TrimSpace(line)
+	if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+		return yamlLine{}, false
+	}
+
+	if m := yamlKeyRegex.FindStringSubmatchIndex(line); m != nil {
+		l := yamlLine{
+			Dash:       m[4] != -1,
+			DashIndent: m[3] - m[2],
+			KeyIndent:  m[6],
+			Key:        line[m[6]:m[7]],
+			Value:      line[m[10]:m[11]],
+			ValueStart: m[10],
+		}
+		return l, true
+	}
+
+	if m := yamlItemRegex.FindStringSubmatchIndex(line); m != nil {
+		return yamlLine{
+			Dash:       true,
+			DashIndent: m[3] - m[2],
+			KeyIndent:  m[6],
+			Value:      line[m[6]:m[7]],
+			ValueStart: m[6],
+		}, true
+	}
+
+	return yamlLine{}, false
+}
+
+// yamlContext describes where the cursor is in a properties file, e.g. for
+// the `name:` of a column of the customers model:
+//
+//	Keys: [models columns], Names: [customers customer_id], Key: name
+type yamlContext struct {
+	// Keys are the enclosing keys, outermost first.
+	Keys []string
+	// Names holds the `name:` of the list item under each key, if any.
+	Names []string
+	// Key is the key on the cursor line, empty for a `- value` entry.
+	Key        string
+	Value      string
+	ValueStart int
+}
+
+func (c yamlContext) path() string {
+	return strings.Join(c.Keys, ".")
+}
+
+// name returns the name of the list item under Keys[i], counting from the
+// end when i is negative.
+func (c yamlContext) name(i int) string {
+	if i < 0 {
+		i += len(c.Names)
+	}
+	if i < 0 || i >= len(c.Names) {
+		return ""
+	}
+	return c.Names[i]
+}
+
+// unquote strips quotes and trailing comments from a scalar value.
+func unquote(value string) string {
+	value = strings.TrimSpace(value)
+	if i := strings.Index(value, " #"); i != -1 {
+		value = strings.TrimSpace(value[:i])
+	}
+	return strings.Trim(value, `'"`)
+}
+
+// contextAt walks up from line, using indentation to find the enclosing
+// keys and the names of the list items the cursor is nested in.
+func (d *yamlDocument) contextAt(line int) (yamlContext, bool) {
+	if line < 0 || line >= len(d.Lines) {
+		return yamlContext{}, false
+	}
+	cur, ok := parseYamlLine(d.Lines[line])
+	if !ok {
+		return yamlContext{}, false
+	}
+
+	ctx := yamlContext{Key: cur.Key, Value: cur.Value, ValueStart: cur.ValueStart}
+
+	keys := []string{}
+	names := []string{}
+
+	// level is the indent of the keys in the mapping being walked and
+	// boundary the indent a parent key must be left of
+	level := cur.KeyIndent
+	boundary := level
+	name := ""
+	if cur.Key == "name" {
+		name = unquote(cur.Value)
+	}
+	if cur.Dash {
+		boundary = cur.DashIndent
+	}
+
+	for i := line - 1; i >= 0; i-- {
+		l, ok := parseYamlLine(d.Lines[i])
+		if !ok {
+			continue
+		}
+
+		if l.KeyIndent < boundary && l.Key != "" {
+			// the parent key of the mapping or list being walked
+			keys = append(keys, l.Key)
+			names = append(names, name)
+
+			level = l.KeyIndent
+			boundary = level
+			name = ""
+			if l.Key == "name" {
+				name = unquote(l.Value)
+			}
+			if l.Dash {
+				boundary = l.DashIndent
+			}
+			continue
+		}
+
+		if l.KeyIndent != level || boundary < level {
+			// deeper content, or a sibling list item
+			continue
+		}
+		if l.Key == "name" && name == "" {
+			name = unquote(l.Value)
+		}
+		if l.Dash {
+			boundary = l.DashIndent
+		}
+	}
+
+	for i, j := 0, len(keys)-1; i < j; i, j = i+1, j-1 {
+		keys[i], keys[j] = keys[j], keys[i]
+		names[i], names[j] = names[j], names[i]
+	}
+	ctx.Keys = keys
+	ctx.Names = names
+
+	return ctx, true
+}
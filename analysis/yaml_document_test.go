@@ -0,0 +1,70 @@
+package analysis
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestYamlContextAt(t *testing.T) {
+	doc := newYamlDocument(`version: 2
+
+models:
+  - name: customers
+    description: One row per customer
+    config:
+      materialized: table
+    columns:
+      - name: customer_id
+        description: Primary key
+        data_tests:
+          - unique
+          - relationships:
+              to: ref('orders')
+              field: customer_id
+
+      - name: 
+  - name: orders
+    columns:
+      - description: documented before its name
+        name: order_id
+
+sources:
+  - name: raw
+    tables:
+      - name: payments
+        columns:
+          - name: "amount"   # in cents
+`)
+
+	tests := []struct {
+		line     int
+		expected yamlContext
+	}{
+		{3, yamlContext{Keys: []string{"models"}, Names: []string{"customers"}, Key: "name", Value: "customers", ValueStart: 10}},
+		{6, yamlContext{Keys: []string{"models", "config"}, Names: []string{"customers", ""}, Key: "materialized", Value: "table", ValueStart: 20}},
+		{9, yamlContext{Keys: []string{"models", "columns"}, Names: []string{"customers", "customer_id"}, Key: "description", Value: "Primary key", ValueStart: 21}},
+		{11, yamlContext{Keys: []string{"models", "columns", "data_tests"}, Names: []string{"customers", "customer_id", ""}, Value: "unique", ValueStart: 12}},
+		{13, yamlContext{Keys: []string{"models", "columns", "data_tests", "relationships"}, Names: []string{"customers", "customer_id", "", ""}, Key: "to", Value: "ref('orders')", ValueStart: 18}},
+		{16, yamlContext{Keys: []string{"models", "columns"}, Names: []string{"customers", ""}, Key: "name", Value: "", ValueStart: 14}},
+		{20, yamlContext{Keys: []string{"models", "columns"}, Names: []string{"orders", "order_id"}, Key: "name", Value: "order_id", ValueStart: 14}},
+		{27, yamlContext{Keys: []string{"sources", "tables", "columns"}, Names: []string{"raw", "payments", "amount"}, Key: "name", Value: `"amount"   # in cents`, ValueStart: 18}},
+	}
+
+	for _, tt := range tests {
+		actual, ok := doc.contextAt(tt.line)
+		if !ok {
+			t.Errorf("line %d: expected a context", tt.line)
+			continue
+		}
+		if !reflect.DeepEqual(actual, tt.expected) {
+			t.Errorf("line %d: expected %+v, got %+v", tt.line, tt.expected, actual)
+		}
+	}
+
+	if _, ok := doc.contextAt(1); ok {
+		t.Error("expected no context on a blank line")
+	}
+	if len(doc.Properties.Models) != 2 || len(doc.Properties.Models[0].Columns) != 2 {
+		t.Errorf("unexpected properties %+v", doc.Properties.Models)
+	}
+}
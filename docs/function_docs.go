@@ -1,33 +1,80 @@
 package docs
 
 import (
+	"regexp"
+	"slices"
+	"strings"
+
 	"github.com/j-clemons/dbt-language-server/lsp"
 	"github.com/j-clemons/dbt-language-server/lsp/completionKind"
 )
 
 type Dialect string
 
-func (d Dialect) FunctionDocs() map[string]string {
+type FunctionDoc struct {
+	Name        string
+	Overloads   []string
+	Parameters  []FunctionParameter
+	Description string
+	URL         string
+}
+
+type FunctionParameter struct {
+	Name        string
+	Description string
+}
+
+func (d Dialect) FunctionDocs() map[string]FunctionDoc {
 	switch d {
 	case "snowflake":
 		return SnowflakeFunctions
 	case "bigquery":
 		return BigQueryFunctions
 	default:
-		return map[string]string{}
+		return map[string]FunctionDoc{}
 	}
 }
 
+func (d Dialect) documentationTitle() string {
+	switch d {
+	case "snowflake":
+		return "Snowflake Documentation"
+	case "bigquery":
+		return "BigQuery Documentation"
+	default:
+		return "Documentation"
+	}
+}
+
+// FunctionMarkdown renders the documentation shown on hover and completion.
+func (d Dialect) FunctionMarkdown(name string) string {
+	f, ok := d.FunctionDocs()[name]
+	if !ok {
+		return ""
+	}
+
+	var b strings.Builder
+	b.WriteString("```sql\n" + strings.Join(f.Overloads, "\n\n") + "\n```\n")
+	if f.Description != "" {
+		b.WriteString(f.Description + "\n")
+	}
+	for _, p := range f.Parameters {
+		b.WriteString("```sql\n" + p.Name + "\n```\n" + p.Description + "\n")
+	}
+	b.WriteString("[" + d.documentationTitle() + "](" + f.URL + ")\n")
+	return b.String()
+}
+
 func (d Dialect) FunctionCompletionItems() []lsp.CompletionItem {
 	items := []lsp.CompletionItem{}
 
 	functions := d.FunctionDocs()
 
-	for k, v := range functions {
+	for k := range functions {
 		items = append(items, lsp.CompletionItem{
 			Label:         k,
 			Detail:        "",
-			Documentation: v,
+			Documentation: d.FunctionMarkdown(k),
 			Kind:          completionKind.Function,
 			InsertText:    k,
 			SortText:      k,
@@ -35,3 +82,86 @@ func (d Dialect) FunctionCompletionItems() []lsp.CompletionItem {
 	}
 	return items
 }
+
+// SignatureParameters returns the [start, end) offsets of each parameter in
+// an overload such as `DATEADD( <date_or_time_part>, <value>, <expr> )`.
+// Square brackets mark optional parameters in the vendor docs, so commas
+// inside them still separate parameters. Optional keywords before a
+// parameter, like `[ DISTINCT ]`, are modifiers and left out of it.
+func SignatureParameters(overload string) [][2]int {
+	open := strings.Index(overload, "(")
+	if open == -1 {
+		return nil
+	}
+
+	params := [][2]int{}
+	addParam := func(start int, stop int) {
+		start = skipOptionalKeywords(overload, start, stop)
+		for start < stop && strings.ContainsRune(" \t\n[]", rune(overload[start])) {
+			start++
+		}
+		for stop > start && strings.ContainsRune(" \t\n[]", rune(overload[stop-1])) {
+			stop--
+		}
+		if start < stop {
+			params = append(params, [2]int{start, stop})
+		}
+	}
+
+	depth := 0
+	start := open + 1
+	for i := open + 1; i < len(overload); i++ {
+		switch overload[i] {
+		case '(':
+			depth++
+		case ')':
+			if depth == 0 {
+				addParam(start, i)
+				return params
+			}
+			depth--
+		case ',':
+			if depth == 0 {
+				addParam(start, i)
+				start = i + 1
+			}
+		}
+	}
+	return nil
+}
+
+// skipOptionalKeywords moves start past bracketed keyword groups, such as
+// `[ DISTINCT ]`, that come before a parameter.
+func skipOptionalKeywords(overload string, start int, stop int) int {
+	for {
+		i := start
+		for i < stop && strings.ContainsRune(" \t\n", rune(overload[i])) {
+			i++
+		}
+		if i >= stop || overload[i] != '[' {
+			return start
+		}
+		end := strings.IndexByte(overload[i:stop], ']')
+		if end == -1 || !optionalKeywordRegex.MatchString(overload[i+1:i+end]) || strings.TrimSpace(overload[i+end+1:stop]) == "" {
+			return start
+		}
+		start = i + end + 1
+	}
+}
+
+var (
+	optionalKeywordRegex = regexp.MustCompile(`^\s*[A-Z][A-Z_ ]*$`)
+	parameterTokenRegex  = regexp.MustCompile(`<[^<>]+>|[A-Za-z_][A-Za-z0-9_.]*`)
+)
+
+// ParameterDescription returns the description of the documented parameter
+// named in label, e.g. `<num_months_expr>`.
+func (f FunctionDoc) ParameterDescription(label string) string {
+	tokens := parameterTokenRegex.FindAllString(label, -1)
+	for _, p := range f.Parameters {
+		if p.Name != "" && slices.Contains(tokens, p.Name) {
+			return p.Description
+		}
+	}
+	return ""
+}
@@ -0,0 +1,98 @@
+package docs
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSignatureParameters(t *testing.T) {
+	testCases := []struct {
+		name     string
+		overload string
+		expected []string
+	}{
+		{
+			name:     "required parameters",
+			overload: "DATEADD( <date_or_time_part>, <value>, <date_or_time_expr> )",
+			expected: []string{"<date_or_time_part>", "<value>", "<date_or_time_expr>"},
+		},
+		{
+			name:     "optional variadic",
+			overload: "APPROX_COUNT_DISTINCT( [ DISTINCT ] <expr1>  [ , ... ] )",
+			expected: []string{"<expr1>", "..."},
+		},
+		{
+			name:     "optional trailing parameter",
+			overload: "ST_DUMP(geography[, dimension])",
+			expected: []string{"geography", "dimension"},
+		},
+		{
+			name:     "nested parentheses",
+			overload: "ARRAY_AGG( <expr> ) WITHIN GROUP ( ORDER BY <expr> )",
+			expected: []string{"<expr>"},
+		},
+		{
+			name:     "unclosed",
+			overload: "BROKEN( <expr>",
+			expected: []string{},
+		},
+		{
+			name:     "no parameters",
+			overload: "CURRENT_DATE()",
+			expected: []string{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := []string{}
+			for _, p := range SignatureParameters(tc.overload) {
+				actual = append(actual, tc.overload[p[0]:p[1]])
+			}
+			if !reflect.DeepEqual(actual, tc.expected) {
+				t.Errorf("got %q, want %q", actual, tc.expected)
+			}
+		})
+	}
+}
+
+func TestParameterDescription(t *testing.T) {
+	doc := FunctionDoc{
+		Parameters: []FunctionParameter{
+			{Name: "<expr>", Description: "The expression."},
+			{Name: "<expr1>", Description: "The first expression."},
+			{Name: "geography", Description: "The geography."},
+		},
+	}
+
+	testCases := []struct {
+		label    string
+		expected string
+	}{
+		{"<expr1>", "The first expression."},
+		{"<expr>", "The expression."},
+		{"geography", "The geography."},
+		{"<expr2>", ""},
+	}
+	for _, tc := range testCases {
+		if actual := doc.ParameterDescription(tc.label); actual != tc.expected {
+			t.Errorf("%s: got %q, want %q", tc.label, actual, tc.expected)
+		}
+	}
+}
+
+func TestFunctionMarkdown(t *testing.T) {
+	expected := "```sql\nACOS( <real_expr> )\n```\n" +
+		"```sql\n<real_expr>\n```\n" +
+		"This expression should evaluate to a real number greater than or equal\nto -1.0 and less than or equal to +1.0.\n" +
+		"[Snowflake Documentation](https://docs.snowflake.com/en/sql-reference/functions/acos)\n"
+
+	actual := Dialect("snowflake").FunctionMarkdown("acos")
+	if actual != expected {
+		t.Errorf("got %q, want %q", actual, expected)
+	}
+
+	if actual := Dialect("duckdb").FunctionMarkdown("acos"); actual != "" {
+		t.Errorf("expected no docs for an unsupported dialect, got %q", actual)
+	}
+}
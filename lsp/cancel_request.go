@@ -0,0 +1,10 @@
+package lsp
+
+type CancelRequestNotification struct {
+	Notification
+	Params CancelParams `json:"params"`
+}
+
+type CancelParams struct {
+	ID RequestID `json:"id"`
+}
@@ -0,0 +1,35 @@
+package lsp
+
+type ColumnLineageRequest struct {
+	Request
+	Params ColumnLineageParams `json:"params"`
+}
+
+// ColumnLineageParams selects the columns to trace. With neither Position
+// nor Column set every output column of the model is returned.
+type ColumnLineageParams struct {
+	TextDocument TextDocumentIdentifier `json:"textDocument"`
+	Position     *Position              `json:"position,omitempty"`
+	Column       string                 `json:"column,omitempty"`
+}
+
+type ColumnLineageResponse struct {
+	Response
+	Result []ColumnLineage `json:"result"`
+}
+
+type ColumnLineage struct {
+	Name       string           `json:"name"`
+	Range      Range            `json:"range"`
+	Expression string           `json:"expression"`
+	Upstream   []UpstreamColumn `json:"upstream"`
+}
+
+type UpstreamColumn struct {
+	// Type is "model" or "source".
+	Type   string `json:"type"`
+	Name   string `json:"name"`
+	Source string `json:"source,omitempty"`
+	Column string `json:"column"`
+	URI    string `json:"uri,omitempty"`
+}
@@ -9,7 +9,27 @@ type InitializeRequest struct {
 
 type InitializeRequestParams struct {
 	ClientInfo ClientInfo `json:"clientInfo"`
-	RootPath   string     `json:"rootPath"`
+	// RootPath is deprecated in favour of RootURI, which is deprecated in
+	// favour of WorkspaceFolders.
+	RootPath         string             `json:"rootPath"`
+	RootURI          string             `json:"rootUri"`
+	WorkspaceFolders []WorkspaceFolder  `json:"workspaceFolders"`
+	Capabilities     ClientCapabilities `json:"capabilities"`
+}
+
+type WorkspaceFolder struct {
+	URI  string `json:"uri"`
+	Name string `json:"name"`
+}
+
+type ClientCapabilities struct {
+	General GeneralClientCapabilities `json:"general"`
+}
+
+type GeneralClientCapabilities struct {
+	// PositionEncodings are the encodings the client can count a position's
+	// character offset in. UTF-16 is always supported.
+	PositionEncodings []string `json:"positionEncodings"`
 }
 
 type ClientInfo struct {
@@ -28,12 +48,29 @@ type InitializeResult struct {
 }
 
 type ServerCapabilities struct {
-	TextDocumentSync int `json:"textDocumentSync"`
+	PositionEncoding string `json:"positionEncoding"`
+	TextDocumentSync int    `json:"textDocumentSync"`
 
-	HoverProvider          bool                  `json:"hoverProvider"`
-	DefinitionProvider     bool                  `json:"definitionProvider"`
-	CompletionProvider     map[string]any        `json:"completionProvider"`
-	ExecuteCommandProvider ExecuteCommandOptions `json:"executeCommandProvider"`
+	HoverProvider           bool                  `json:"hoverProvider"`
+	DefinitionProvider      bool                  `json:"definitionProvider"`
+	ReferencesProvider      bool                  `json:"referencesProvider"`
+	RenameProvider          RenameOptions         `json:"renameProvider"`
+	DocumentSymbolProvider  bool                  `json:"documentSymbolProvider"`
+	WorkspaceSymbolProvider bool                  `json:"workspaceSymbolProvider"`
+	CallHierarchyProvider   bool                  `json:"callHierarchyProvider"`
+	CompletionProvider      map[string]any        `json:"completionProvider"`
+	SignatureHelpProvider   SignatureHelpOptions  `json:"signatureHelpProvider"`
+	ExecuteCommandProvider  ExecuteCommandOptions `json:"executeCommandProvider"`
+	Workspace               WorkspaceCapabilities `json:"workspace"`
+}
+
+type WorkspaceCapabilities struct {
+	WorkspaceFolders WorkspaceFoldersServerCapabilities `json:"workspaceFolders"`
+}
+
+type WorkspaceFoldersServerCapabilities struct {
+	Supported           bool `json:"supported"`
+	ChangeNotifications bool `json:"changeNotifications"`
 }
 
 type ExecuteCommandOptions struct {
@@ -45,21 +82,38 @@ type ServerInfo struct {
 	Version string `json:"version"`
 }
 
-func NewInitializeResponse(id int) InitializeResponse {
+func NewInitializeResponse(id RequestID, positionEncoding string) InitializeResponse {
 	return InitializeResponse{
 		Response: Response{
 			RPC: "2.0",
-			ID:  &id,
+			ID:  id,
 		},
 		Result: InitializeResult{
 			Capabilities: ServerCapabilities{
+				PositionEncoding:   positionEncoding,
 				TextDocumentSync:   2,
 				HoverProvider:      true,
 				DefinitionProvider: true,
-				CompletionProvider: map[string]any{},
+				ReferencesProvider: true,
+				RenameProvider: RenameOptions{
+					PrepareProvider: true,
+				},
+				DocumentSymbolProvider:  true,
+				WorkspaceSymbolProvider: true,
+				CallHierarchyProvider:   true,
+				CompletionProvider:      map[string]any{},
+				SignatureHelpProvider: SignatureHelpOptions{
+					TriggerCharacters: []string{"(", ","},
+				},
 				ExecuteCommandProvider: ExecuteCommandOptions{
 					Commands: []string{"dbt.goToSchema"},
 				},
+				Workspace: WorkspaceCapabilities{
+					WorkspaceFolders: WorkspaceFoldersServerCapabilities{
+						Supported:           true,
+						ChangeNotifications: true,
+					},
+				},
 			},
 			ServerInfo: ServerInfo{
 				Name:    "dbt-language-server",
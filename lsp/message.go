@@ -1,17 +1,21 @@
 package lsp
 
+// RequestID is the id of a request, an integer or a string. It's decoded
+// as a float64 or a string, and a response echoes it back as it was sent.
+type RequestID any
+
 type Request struct {
-	RPC    string `json:"jsonrpc"`
-	ID     int    `json:"id"`
-	Method string `json:"method"`
+	RPC    string    `json:"jsonrpc"`
+	ID     RequestID `json:"id"`
+	Method string    `json:"method"`
 
 	// Specify params for all request types
 	// Params
 }
 
 type Response struct {
-	RPC string `json:"jsonrpc"`
-	ID  *int   `json:"id"`
+	RPC string    `json:"jsonrpc"`
+	ID  RequestID `json:"id"`
 
 	// Result
 	// Error
@@ -21,3 +25,46 @@ type Notification struct {
 	RPC    string `json:"jsonrpc"`
 	Method string `json:"method"`
 }
+
+// JSON-RPC and LSP error codes.
+const (
+	ParseError     = -32700
+	InvalidRequest = -32600
+	MethodNotFound = -32601
+	InvalidParams  = -32602
+	InternalError  = -32603
+	// ServerNotInitialized is returned for requests sent before initialize.
+	ServerNotInitialized = -32002
+	// RequestCancelled is returned for requests the client cancelled with
+	// $/cancelRequest.
+	RequestCancelled = -32800
+)
+
+type ResponseError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *ResponseError) Error() string {
+	return e.Message
+}
+
+type ErrorResponse struct {
+	Response
+	Error ResponseError `json:"error"`
+}
+
+// NewErrorResponse returns an error response. id is nil when the request's
+// id couldn't be read, e.g. for a ParseError.
+func NewErrorResponse(id RequestID, code int, message string) ErrorResponse {
+	return ErrorResponse{
+		Response: Response{
+			RPC: "2.0",
+			ID:  id,
+		},
+		Error: ResponseError{
+			Code:    code,
+			Message: message,
+		},
+	}
+}
@@ -0,0 +1,16 @@
+package lsp
+
+// ShutdownResponse has a null result, which the spec requires.
+type ShutdownResponse struct {
+	Response
+	Result *struct{} `json:"result"`
+}
+
+func NewShutdownResponse(id RequestID) ShutdownResponse {
+	return ShutdownResponse{
+		Response: Response{
+			RPC: "2.0",
+			ID:  id,
+		},
+	}
+}
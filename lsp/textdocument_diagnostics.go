@@ -17,3 +17,16 @@ type Diagnostic struct {
 	Code     string `json:"code"`
 	Source   string `json:"source"`
 }
+
+func NewDiagnosticsNotification(uri string, diagnostics []Diagnostic) DiagnosticsNotification {
+	return DiagnosticsNotification{
+		Notification: Notification{
+			RPC:    "2.0",
+			Method: "textDocument/publishDiagnostics",
+		},
+		Params: PublishDiagnosticsParams{
+			URI:         uri,
+			Diagnostics: diagnostics,
+		},
+	}
+}
@@ -0,0 +1,15 @@
+package lsp
+
+type DidChangeWorkspaceFoldersNotification struct {
+	Notification
+	Params DidChangeWorkspaceFoldersParams `json:"params"`
+}
+
+type DidChangeWorkspaceFoldersParams struct {
+	Event WorkspaceFoldersChangeEvent `json:"event"`
+}
+
+type WorkspaceFoldersChangeEvent struct {
+	Added   []WorkspaceFolder `json:"added"`
+	Removed []WorkspaceFolder `json:"removed"`
+}
@@ -2,12 +2,15 @@ package main
 
 import (
 	"bufio"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"log"
 	"os"
 	"os/exec"
+	"runtime"
+	"strings"
 
 	flag "github.com/spf13/pflag"
 
@@ -67,39 +70,20 @@ func main() {
 	logger.Println("dbt Language Server Started!")
 	scanner := bufio.NewScanner(os.Stdin)
 	scanner.Split(rpc.Split)
-	writer := os.Stdout
+	server := newServer(logger, rpc.NewWriter(os.Stdout), &state, runtime.NumCPU())
 
 	for scanner.Scan() {
-		msg := scanner.Bytes()
-		method, contents, err := rpc.DecodeMessage(msg)
-		if err != nil {
-			logger.Printf("Got an error: %s", err)
-		}
-		handleMessage(logger, writer, &state, method, contents)
+		server.dispatch(scanner.Bytes())
 	}
 }
 
+// handleMessage handles notifications, in the order they arrive.
 func handleMessage(logger *log.Logger, writer io.Writer, state *analysis.State, method string, contents []byte) {
 	logger.Printf("Received msg with method: %s", method)
 
 	switch method {
-	case "initialize":
-		var request lsp.InitializeRequest
-		if err := json.Unmarshal(contents, &request); err != nil {
-			logger.Printf("Could not parse: %s", err)
-		}
-
-		logger.Printf("Connected to: %s %s %s",
-			request.Params.ClientInfo.Name,
-			request.Params.ClientInfo.Version,
-			request.Params.RootPath,
-		)
-
-		msg := lsp.NewInitializeResponse(request.ID)
-		util.WriteResponse(writer, msg)
-		state.LspClientRootPath = request.Params.RootPath
-
-		logger.Print("Sent the reply")
+	case "initialized":
+		logger.Print("Client initialized")
 	case "textDocument/didOpen":
 		var request lsp.DidOpenTextDocumentNotification
 		if err := json.Unmarshal(contents, &request); err != nil {
@@ -110,7 +94,8 @@ func handleMessage(logger *log.Logger, writer io.Writer, state *analysis.State,
 		state.OpenDocument(request.Params.TextDocument.URI, request.Params.TextDocument.Text)
 		logger.Printf("Opened: %s", request.Params.TextDocument.URI)
 
-		fusion.FusionCompile(state, request.Params.TextDocument.URI, logger, writer)
+		publishNativeDiagnostics(writer, state, request.Params.TextDocument.URI)
+		go fusion.FusionCompile(context.Background(), state, request.Params.TextDocument.URI, logger, writer)
 	case "textDocument/didSave":
 		logger.Print("textDocument/didSave")
 		var request lsp.DidSaveTextDocumentNotification
@@ -122,7 +107,7 @@ func handleMessage(logger *log.Logger, writer io.Writer, state *analysis.State,
 		logger.Printf("Saved: %s", request.Params.TextDocument.URI)
 		state.SaveDocument(request.Params.TextDocument.URI)
 
-		fusion.FusionCompile(state, request.Params.TextDocument.URI, logger, writer)
+		go fusion.FusionCompile(context.Background(), state, request.Params.TextDocument.URI, logger, writer)
 	case "textDocument/didChange":
 		var request lsp.TextDocumentDidChangeNotification
 		if err := json.Unmarshal(contents, &request); err != nil {
@@ -132,81 +117,323 @@ func handleMessage(logger *log.Logger, writer io.Writer, state *analysis.State,
 
 		logger.Printf("Changed: %s", request.Params.TextDocument.URI)
 		state.UpdateDocumentIncremental(request.Params.TextDocument.URI, request.Params.ContentChanges)
+
+		publishNativeDiagnostics(writer, state, request.Params.TextDocument.URI)
+	case "textDocument/didClose":
+		var request lsp.DidCloseTextDocumentNotification
+		if err := json.Unmarshal(contents, &request); err != nil {
+			logger.Printf("textDocument/didClose: %s", err)
+			return
+		}
+
+		logger.Printf("Closed: %s", request.Params.TextDocument.URI)
+		state.CloseDocument(request.Params.TextDocument.URI)
+
+		util.WriteResponse(writer, lsp.NewDiagnosticsNotification(request.Params.TextDocument.URI, []lsp.Diagnostic{}))
+	case "workspace/didChangeWorkspaceFolders":
+		var request lsp.DidChangeWorkspaceFoldersNotification
+		if err := json.Unmarshal(contents, &request); err != nil {
+			logger.Printf("workspace/didChangeWorkspaceFolders: %s", err)
+			return
+		}
+
+		added := folderPaths(request.Params.Event.Added)
+		removed := folderPaths(request.Params.Event.Removed)
+		logger.Printf("Workspace folders added: %v, removed: %v", added, removed)
+		state.UpdateWorkspaceFolders(added, removed)
+	case "exit":
+		logger.Print("Received exit notification")
+		os.Exit(exitCode(state))
+	}
+}
+
+// exitCode is 0 when the client sent shutdown before exit, and 1 otherwise.
+func exitCode(state *analysis.State) int {
+	if state.Lifecycle() == analysis.ShutDown {
+		return 0
+	}
+	return 1
+}
+
+// handleRequest returns the response to a request, or a *lsp.ResponseError.
+// Requests other than initialize and shutdown only read the state, so they
+// can be handled concurrently.
+func handleRequest(ctx context.Context, logger *log.Logger, state *analysis.State, method string, contents []byte) (any, error) {
+	logger.Printf("Received request with method: %s", method)
+
+	switch method {
+	case "initialize":
+		var request lsp.InitializeRequest
+		if err := json.Unmarshal(contents, &request); err != nil {
+			logger.Printf("initialize: %s", err)
+			return nil, invalidParams(err)
+		}
+
+		rootPath, folders := workspaceFolders(request.Params)
+		logger.Printf("Connected to: %s %s %s",
+			request.Params.ClientInfo.Name,
+			request.Params.ClientInfo.Version,
+			rootPath,
+		)
+
+		state.SetLspClientRootPath(rootPath)
+		state.SetWorkspaceFolders(folders)
+		encoding := analysis.NegotiatePositionEncoding(request.Params.Capabilities.General.PositionEncodings)
+		state.SetPositionEncoding(encoding)
+		state.SetLifecycle(analysis.Initialized)
+
+		return lsp.NewInitializeResponse(request.ID, string(encoding)), nil
+	case "shutdown":
+		var request lsp.Request
+		if err := json.Unmarshal(contents, &request); err != nil {
+			logger.Printf("shutdown: %s", err)
+			return nil, invalidParams(err)
+		}
+
+		logger.Print("Received shutdown request")
+		state.SetLifecycle(analysis.ShutDown)
+
+		return lsp.NewShutdownResponse(request.ID), nil
 	case "textDocument/hover":
 		var request lsp.HoverRequest
 		if err := json.Unmarshal(contents, &request); err != nil {
 			logger.Printf("textDocument/hover: %s", err)
-			return
+			return nil, invalidParams(err)
+		}
+
+		uri := request.Params.TextDocument.URI
+		response := state.Hover(request.ID, uri, state.DecodePosition(uri, request.Params.Position))
+
+		return response, nil
+	case "textDocument/signatureHelp":
+		var request lsp.SignatureHelpRequest
+		if err := json.Unmarshal(contents, &request); err != nil {
+			logger.Printf("textDocument/signatureHelp: %s", err)
+			return nil, invalidParams(err)
+		}
+
+		uri := request.Params.TextDocument.URI
+		response := state.SignatureHelp(request.ID, uri, state.DecodePosition(uri, request.Params.Position))
+
+		return state.EncodePositions(uri, response), nil
+	case "dbt/columnLineage":
+		var request lsp.ColumnLineageRequest
+		if err := json.Unmarshal(contents, &request); err != nil {
+			logger.Printf("dbt/columnLineage: %s", err)
+			return nil, invalidParams(err)
 		}
 
-		response := state.Hover(request.ID, request.Params.TextDocument.URI, request.Params.Position)
+		uri := request.Params.TextDocument.URI
+		if request.Params.Position != nil {
+			position := state.DecodePosition(uri, *request.Params.Position)
+			request.Params.Position = &position
+		}
+		response := state.ColumnLineage(request.ID, request.Params)
 
-		util.WriteResponse(writer, response)
+		return state.EncodePositions(uri, response), nil
 	case "textDocument/definition":
 		logger.Print("textDocument/definition")
 		var request lsp.DefinitionRequest
 		if err := json.Unmarshal(contents, &request); err != nil {
 			logger.Printf("textDocument/definition: %s", err)
-			return
+			return nil, invalidParams(err)
 		}
 
-		response := state.Definition(request.ID, request.Params.TextDocument.URI, request.Params.Position)
+		uri := request.Params.TextDocument.URI
+		response := state.Definition(request.ID, uri, state.DecodePosition(uri, request.Params.Position))
 
-		util.WriteResponse(writer, response)
-	case "textDocument/completion":
-		logger.Print("textDocument/completion")
-		var request lsp.CompletionRequest
+		return state.EncodePositions(uri, response), nil
+	case "textDocument/references":
+		logger.Print("textDocument/references")
+		var request lsp.ReferencesRequest
 		if err := json.Unmarshal(contents, &request); err != nil {
-			logger.Printf("textDocument/completion: %s", err)
-			return
+			logger.Printf("textDocument/references: %s", err)
+			return nil, invalidParams(err)
 		}
 
-		response := state.TextDocumentCompletion(request.ID, request.Params.TextDocument.URI, request.Params.Position)
+		uri := request.Params.TextDocument.URI
+		response := state.References(
+			ctx,
+			request.ID,
+			uri,
+			state.DecodePosition(uri, request.Params.Position),
+			request.Params.Context.IncludeDeclaration,
+		)
 
-		util.WriteResponse(writer, response)
-	case "shutdown":
-		var request lsp.Request
+		return state.EncodePositions(uri, response), nil
+	case "textDocument/prepareRename":
+		logger.Print("textDocument/prepareRename")
+		var request lsp.PrepareRenameRequest
 		if err := json.Unmarshal(contents, &request); err != nil {
-			logger.Printf("shutdown: %s", err)
-			return
+			logger.Printf("textDocument/prepareRename: %s", err)
+			return nil, invalidParams(err)
 		}
 
-		logger.Print("Received shutdown request")
-		response := lsp.Response{
-			RPC: "2.0",
-			ID:  &request.ID,
+		uri := request.Params.TextDocument.URI
+		response := state.PrepareRename(request.ID, uri, state.DecodePosition(uri, request.Params.Position))
+
+		return state.EncodePositions(uri, response), nil
+	case "textDocument/rename":
+		logger.Print("textDocument/rename")
+		var request lsp.RenameRequest
+		if err := json.Unmarshal(contents, &request); err != nil {
+			logger.Printf("textDocument/rename: %s", err)
+			return nil, invalidParams(err)
 		}
-		util.WriteResponse(writer, response)
-	case "exit":
-		logger.Print("Received exit notification")
-		os.Exit(0)
+
+		uri := request.Params.TextDocument.URI
+		response := state.Rename(
+			ctx,
+			request.ID,
+			uri,
+			state.DecodePosition(uri, request.Params.Position),
+			request.Params.NewName,
+		)
+
+		return state.EncodePositions(uri, response), nil
+	case "textDocument/documentSymbol":
+		logger.Print("textDocument/documentSymbol")
+		var request lsp.DocumentSymbolRequest
+		if err := json.Unmarshal(contents, &request); err != nil {
+			logger.Printf("textDocument/documentSymbol: %s", err)
+			return nil, invalidParams(err)
+		}
+
+		response := state.DocumentSymbol(request.ID, request.Params.TextDocument.URI)
+
+		return state.EncodePositions(request.Params.TextDocument.URI, response), nil
+	case "workspace/symbol":
+		logger.Print("workspace/symbol")
+		var request lsp.WorkspaceSymbolRequest
+		if err := json.Unmarshal(contents, &request); err != nil {
+			logger.Printf("workspace/symbol: %s", err)
+			return nil, invalidParams(err)
+		}
+
+		response := state.WorkspaceSymbol(ctx, request.ID, request.Params.Query)
+
+		return state.EncodePositions("", response), nil
+	case "textDocument/prepareCallHierarchy":
+		logger.Print("textDocument/prepareCallHierarchy")
+		var request lsp.CallHierarchyPrepareRequest
+		if err := json.Unmarshal(contents, &request); err != nil {
+			logger.Printf("textDocument/prepareCallHierarchy: %s", err)
+			return nil, invalidParams(err)
+		}
+
+		uri := request.Params.TextDocument.URI
+		response := state.PrepareCallHierarchy(request.ID, uri, state.DecodePosition(uri, request.Params.Position))
+
+		return state.EncodePositions(uri, response), nil
+	case "callHierarchy/incomingCalls":
+		logger.Print("callHierarchy/incomingCalls")
+		var request lsp.CallHierarchyIncomingCallsRequest
+		if err := json.Unmarshal(contents, &request); err != nil {
+			logger.Printf("callHierarchy/incomingCalls: %s", err)
+			return nil, invalidParams(err)
+		}
+
+		response := state.IncomingCalls(ctx, request.ID, request.Params.Item)
+
+		return state.EncodePositions(request.Params.Item.URI, response), nil
+	case "callHierarchy/outgoingCalls":
+		logger.Print("callHierarchy/outgoingCalls")
+		var request lsp.CallHierarchyOutgoingCallsRequest
+		if err := json.Unmarshal(contents, &request); err != nil {
+			logger.Printf("callHierarchy/outgoingCalls: %s", err)
+			return nil, invalidParams(err)
+		}
+
+		response := state.OutgoingCalls(request.ID, request.Params.Item)
+
+		return state.EncodePositions(request.Params.Item.URI, response), nil
+	case "textDocument/completion":
+		logger.Print("textDocument/completion")
+		var request lsp.CompletionRequest
+		if err := json.Unmarshal(contents, &request); err != nil {
+			logger.Printf("textDocument/completion: %s", err)
+			return nil, invalidParams(err)
+		}
+
+		uri := request.Params.TextDocument.URI
+		response := state.TextDocumentCompletion(request.ID, uri, state.DecodePosition(uri, request.Params.Position))
+
+		return response, nil
 	case "workspace/executeCommand":
 		logger.Print("workspace/executeCommand")
 		var request lsp.ExecuteCommandRequest
 		if err := json.Unmarshal(contents, &request); err != nil {
 			logger.Printf("workspace/executeCommand: %s", err)
-			return
+			return nil, invalidParams(err)
 		}
 
-		if request.Params.Command == "dbt.goToSchema" {
-			// Parse arguments to get URI and position
-			if len(request.Params.Arguments) >= 1 {
-				argMap, ok := request.Params.Arguments[0].(map[string]interface{})
-				if ok {
-					uri, _ := argMap["uri"].(string)
-					positionMap, _ := argMap["position"].(map[string]interface{})
-					line, _ := positionMap["line"].(float64)
-					character, _ := positionMap["character"].(float64)
-
-					position := lsp.Position{
-						Line:      int(line),
-						Character: int(character),
-					}
-
-					response := state.GoToSchema(request.ID, uri, position)
-					util.WriteResponse(writer, response)
-				}
-			}
+		if request.Params.Command != "dbt.goToSchema" {
+			return nil, &lsp.ResponseError{Code: lsp.InvalidParams, Message: fmt.Sprintf("Unknown command: %s", request.Params.Command)}
+		}
+
+		// Parse arguments to get URI and position
+		if len(request.Params.Arguments) < 1 {
+			return nil, &lsp.ResponseError{Code: lsp.InvalidParams, Message: "dbt.goToSchema expects a uri and position"}
+		}
+		argMap, ok := request.Params.Arguments[0].(map[string]interface{})
+		if !ok {
+			return nil, &lsp.ResponseError{Code: lsp.InvalidParams, Message: "dbt.goToSchema expects a uri and position"}
 		}
+		uri, _ := argMap["uri"].(string)
+		positionMap, _ := argMap["position"].(map[string]interface{})
+		line, _ := positionMap["line"].(float64)
+		character, _ := positionMap["character"].(float64)
+
+		position := state.DecodePosition(uri, lsp.Position{
+			Line:      int(line),
+			Character: int(character),
+		})
+
+		return state.EncodePositions(uri, state.GoToSchema(request.ID, uri, position)), nil
 	}
+
+	return nil, &lsp.ResponseError{Code: lsp.MethodNotFound, Message: fmt.Sprintf("Method not found: %s", method)}
+}
+
+// invalidParams is the error for a request whose params don't unmarshal.
+func invalidParams(err error) error {
+	return &lsp.ResponseError{Code: lsp.InvalidParams, Message: err.Error()}
+}
+
+// workspaceFolders reads the client's root path and workspace folders,
+// falling back to the deprecated rootUri and rootPath for older clients.
+func workspaceFolders(params lsp.InitializeRequestParams) (string, []string) {
+	rootPath := params.RootPath
+	if params.RootURI != "" {
+		rootPath = strings.TrimPrefix(params.RootURI, "file://")
+	}
+
+	folders := folderPaths(params.WorkspaceFolders)
+	if len(folders) == 0 && rootPath != "" {
+		folders = []string{rootPath}
+	}
+	if rootPath == "" && len(folders) > 0 {
+		rootPath = folders[0]
+	}
+	return rootPath, folders
+}
+
+// folderPaths converts workspace folder URIs to paths.
+func folderPaths(folders []lsp.WorkspaceFolder) []string {
+	paths := []string{}
+	for _, folder := range folders {
+		paths = append(paths, strings.TrimPrefix(folder.URI, "file://"))
+	}
+	return paths
+}
+
+// publishNativeDiagnostics reports unresolved dbt references when fusion is
+// not available to do the static analysis.
+func publishNativeDiagnostics(writer io.Writer, state *analysis.State, uri string) {
+	if state.IsFusionEnabled() {
+		return
+	}
+
+	notification := lsp.NewDiagnosticsNotification(uri, state.Diagnostics(uri))
+	util.WriteResponse(writer, state.EncodePositions(uri, notification))
 }
@@ -1,6 +1,9 @@
 package rpc_test
 
 import (
+	"bufio"
+	"bytes"
+	"sync"
 	"testing"
 
 	"github.com/j-clemons/dbt-language-server/rpc"
@@ -34,3 +37,31 @@ func TestDecode(t *testing.T) {
 		t.Fatalf("Expected: 'hi', Got: %s", method)
 	}
 }
+
+func TestWriterSerializesMessages(t *testing.T) {
+	var buf bytes.Buffer
+	writer := rpc.NewWriter(&buf)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			writer.Write([]byte(rpc.EncodeMessage(EncodingExample{Testing: i%2 == 0})))
+		}(i)
+	}
+	wg.Wait()
+
+	scanner := bufio.NewScanner(&buf)
+	scanner.Split(rpc.Split)
+	count := 0
+	for scanner.Scan() {
+		if _, _, err := rpc.DecodeMessage(scanner.Bytes()); err != nil {
+			t.Fatalf("message %d was interleaved: %v", count, err)
+		}
+		count++
+	}
+	if count != 50 {
+		t.Errorf("expected 50 messages, got %d", count)
+	}
+}
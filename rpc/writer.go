@@ -0,0 +1,24 @@
+package rpc
+
+import (
+	"io"
+	"sync"
+)
+
+// Writer serializes the writes of concurrent handlers, so each message
+// reaches the client whole. Every Write must be one complete message, as
+// written by EncodeMessage.
+type Writer struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{w: w}
+}
+
+func (w *Writer) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.w.Write(p)
+}
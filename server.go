@@ -0,0 +1,234 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"runtime/debug"
+	"sync"
+
+	"github.com/j-clemons/dbt-language-server/analysis"
+	"github.com/j-clemons/dbt-language-server/lsp"
+	"github.com/j-clemons/dbt-language-server/rpc"
+	"github.com/j-clemons/dbt-language-server/util"
+)
+
+// orderedRequests are handled on the read loop with the notifications,
+// since every other message depends on them.
+var orderedRequests = map[string]bool{
+	"initialize": true,
+	"shutdown":   true,
+}
+
+// server dispatches the client's messages. Notifications change the state,
+// so they're handled on the read loop in the order they arrive. Other
+// requests run on a pool of workers, so a slow one doesn't hold up hovers
+// and completions, and can be cancelled with $/cancelRequest.
+type server struct {
+	logger *log.Logger
+	// writer must be safe for concurrent use, like rpc.Writer.
+	writer  io.Writer
+	state   *analysis.State
+	workers chan struct{}
+	// handle is handleRequest, other than in tests. ctx is cancelled with
+	// the request.
+	handle func(ctx context.Context, logger *log.Logger, state *analysis.State, method string, contents []byte) (any, error)
+
+	mu       sync.Mutex
+	inFlight map[lsp.RequestID]context.CancelFunc
+}
+
+func newServer(logger *log.Logger, writer io.Writer, state *analysis.State, workers int) *server {
+	if workers < 1 {
+		workers = 1
+	}
+	return &server{
+		logger:   logger,
+		writer:   writer,
+		state:    state,
+		workers:  make(chan struct{}, workers),
+		handle:   handleRequest,
+		inFlight: map[lsp.RequestID]context.CancelFunc{},
+	}
+}
+
+// dispatch handles one message read from the client.
+func (s *server) dispatch(msg []byte) {
+	method, contents, err := rpc.DecodeMessage(msg)
+	if err != nil {
+		s.logger.Printf("Got an error: %s", err)
+		s.writeError(nil, lsp.ParseError, err.Error())
+		return
+	}
+
+	var message struct {
+		ID json.RawMessage `json:"id"`
+	}
+	if err := json.Unmarshal(contents, &message); err != nil {
+		s.writeError(nil, lsp.InvalidRequest, err.Error())
+		return
+	}
+
+	if len(message.ID) == 0 || string(message.ID) == "null" {
+		if method == "" {
+			s.writeError(nil, lsp.InvalidRequest, "message has no method")
+			return
+		}
+		s.notify(method, contents)
+		return
+	}
+
+	var id lsp.RequestID
+	if err := json.Unmarshal(message.ID, &id); err != nil || !validID(id) {
+		s.writeError(nil, lsp.InvalidRequest, fmt.Sprintf("request id %s is not an integer or a string", message.ID))
+		return
+	}
+	if method == "" {
+		// a response to a request the server sent
+		s.logger.Printf("Ignoring response %s", message.ID)
+		return
+	}
+	switch lifecycle := s.state.Lifecycle(); {
+	case lifecycle == analysis.Uninitialized && method != "initialize":
+		s.writeError(id, lsp.ServerNotInitialized, fmt.Sprintf("%s was sent before initialize", method))
+		return
+	case lifecycle == analysis.Initialized && method == "initialize":
+		s.writeError(id, lsp.InvalidRequest, "initialize was sent twice")
+		return
+	case lifecycle == analysis.ShutDown:
+		s.writeError(id, lsp.InvalidRequest, fmt.Sprintf("%s was sent after shutdown", method))
+		return
+	}
+
+	if orderedRequests[method] {
+		s.respond(context.Background(), id, method, contents)
+		return
+	}
+
+	// the scanner reuses its buffer for the next message
+	s.start(id, method, bytes.Clone(contents))
+}
+
+// notify handles a notification. Before initialize and after shutdown,
+// only exit is handled.
+func (s *server) notify(method string, contents []byte) {
+	if method == "$/cancelRequest" {
+		s.cancel(contents)
+		return
+	}
+	if s.state.Lifecycle() != analysis.Initialized && method != "exit" {
+		s.logger.Printf("Dropped %s sent outside the server's lifecycle", method)
+		return
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			s.logger.Printf("%s panicked: %v\n%s", method, r, debug.Stack())
+		}
+	}()
+	handleMessage(s.logger, s.writer, s.state, method, contents)
+}
+
+func (s *server) start(id lsp.RequestID, method string, contents []byte) {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.mu.Lock()
+	s.inFlight[id] = cancel
+	s.mu.Unlock()
+
+	go func() {
+		defer s.finish(id, cancel)
+
+		select {
+		case s.workers <- struct{}{}:
+			defer func() { <-s.workers }()
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			s.writeCancelled(id, method)
+			return
+		}
+
+		s.respond(ctx, id, method, contents)
+	}()
+}
+
+// respond handles a request and writes its response, or its error. A panic
+// becomes an InternalError rather than taking down the server.
+func (s *server) respond(ctx context.Context, id lsp.RequestID, method string, contents []byte) {
+	response, err := s.call(ctx, method, contents)
+	if ctx.Err() != nil {
+		s.writeCancelled(id, method)
+		return
+	}
+	if err != nil {
+		var responseError *lsp.ResponseError
+		if !errors.As(err, &responseError) {
+			responseError = &lsp.ResponseError{Code: lsp.InternalError, Message: err.Error()}
+		}
+		s.logger.Printf("%s: %s", method, responseError.Message)
+		s.writeError(id, responseError.Code, responseError.Message)
+		return
+	}
+	util.WriteResponse(s.writer, response)
+}
+
+func (s *server) call(ctx context.Context, method string, contents []byte) (response any, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			s.logger.Printf("%s panicked: %v\n%s", method, r, debug.Stack())
+			response = nil
+			err = &lsp.ResponseError{Code: lsp.InternalError, Message: fmt.Sprintf("%s failed: %v", method, r)}
+		}
+	}()
+	return s.handle(ctx, s.logger, s.state, method, contents)
+}
+
+func (s *server) finish(id lsp.RequestID, cancel context.CancelFunc) {
+	cancel()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.inFlight, id)
+}
+
+func (s *server) cancel(contents []byte) {
+	var notification lsp.CancelRequestNotification
+	if err := json.Unmarshal(contents, &notification); err != nil {
+		s.logger.Printf("$/cancelRequest: %s", err)
+		return
+	}
+
+	if !validID(notification.Params.ID) {
+		s.logger.Printf("$/cancelRequest: request id %v is not an integer or a string", notification.Params.ID)
+		return
+	}
+
+	s.mu.Lock()
+	cancel, ok := s.inFlight[notification.Params.ID]
+	s.mu.Unlock()
+	if ok {
+		s.logger.Printf("Cancelled request %v", notification.Params.ID)
+		cancel()
+	}
+}
+
+// validID reports whether id is an integer or a string, the ids JSON-RPC
+// allows for a request.
+func validID(id lsp.RequestID) bool {
+	switch id.(type) {
+	case float64, string:
+		return true
+	}
+	return false
+}
+
+func (s *server) writeCancelled(id lsp.RequestID, method string) {
+	s.writeError(id, lsp.RequestCancelled, method+" was cancelled")
+}
+
+func (s *server) writeError(id lsp.RequestID, code int, message string) {
+	util.WriteResponse(s.writer, lsp.NewErrorResponse(id, code, message))
+}
@@ -0,0 +1,315 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/j-clemons/dbt-language-server/analysis"
+	"github.com/j-clemons/dbt-language-server/lsp"
+	"github.com/j-clemons/dbt-language-server/rpc"
+)
+
+// messageWriter sends each message written to it on a channel.
+type messageWriter chan []byte
+
+func (w messageWriter) Write(p []byte) (int, error) {
+	w <- append([]byte{}, p...)
+	return len(p), nil
+}
+
+func (w messageWriter) next(t *testing.T) map[string]any {
+	t.Helper()
+	select {
+	case msg := <-w:
+		_, contents, err := rpc.DecodeMessage(msg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var decoded map[string]any
+		if err := json.Unmarshal(contents, &decoded); err != nil {
+			t.Fatal(err)
+		}
+		return decoded
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for a message")
+	}
+	return nil
+}
+
+func (w messageWriter) expectNone(t *testing.T) {
+	t.Helper()
+	select {
+	case msg := <-w:
+		t.Errorf("unexpected message %s", msg)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func frame(contents string) []byte {
+	return []byte(fmt.Sprintf("Content-Length: %d\r\n\r\n%s", len(contents), contents))
+}
+
+func hoverMessage(id string) []byte {
+	return frame(`{"jsonrpc":"2.0","id":` + id + `,"method":"textDocument/hover","params":{"textDocument":{"uri":"file:///models/a.sql"},"position":{"line":0,"character":0}}}`)
+}
+
+func errorCode(response map[string]any) any {
+	responseError, _ := response["error"].(map[string]any)
+	return responseError["code"]
+}
+
+// newTestServer returns an initialized server with file:///models/a.sql open.
+func newTestServer(t *testing.T, workers int) (*server, messageWriter) {
+	t.Helper()
+	state := analysis.NewState()
+	state.UpdateDocument("file:///models/a.sql", "select 1")
+	writer := make(messageWriter, 10)
+	server := newServer(log.New(io.Discard, "", 0), writer, &state, workers)
+
+	server.dispatch(frame(`{"jsonrpc":"2.0","id":0,"method":"initialize","params":{}}`))
+	if response := writer.next(t); response["error"] != nil {
+		t.Fatalf("initialize failed: %v", response)
+	}
+	return server, writer
+}
+
+func TestServerCancelRequest(t *testing.T) {
+	server, writer := newTestServer(t, 1)
+
+	// with the only worker busy, the request waits until it's cancelled
+	server.workers <- struct{}{}
+	server.dispatch(hoverMessage("7"))
+	server.dispatch(frame(`{"jsonrpc":"2.0","method":"$/cancelRequest","params":{"id":7}}`))
+
+	response := writer.next(t)
+	if response["id"] != float64(7) || errorCode(response) != float64(-32800) {
+		t.Fatalf("expected a RequestCancelled error for request 7, got %v", response)
+	}
+
+	<-server.workers
+	server.dispatch(hoverMessage("8"))
+	response = writer.next(t)
+	if response["id"] != float64(8) || response["error"] != nil {
+		t.Errorf("expected a hover result for request 8, got %v", response)
+	}
+
+	// cancelling a finished request is a no-op
+	server.dispatch(frame(`{"jsonrpc":"2.0","method":"$/cancelRequest","params":{"id":8}}`))
+	writer.expectNone(t)
+
+	// string ids are cancelled and echoed back the same way
+	server.workers <- struct{}{}
+	server.dispatch(hoverMessage(`"hover-9"`))
+	server.dispatch(frame(`{"jsonrpc":"2.0","method":"$/cancelRequest","params":{"id":"hover-9"}}`))
+	response = writer.next(t)
+	if response["id"] != "hover-9" || errorCode(response) != float64(-32800) {
+		t.Fatalf("expected a RequestCancelled error for request hover-9, got %v", response)
+	}
+	<-server.workers
+}
+
+func TestServerCancelsHandlerContext(t *testing.T) {
+	server, writer := newTestServer(t, 1)
+	started := make(chan struct{})
+	server.handle = func(ctx context.Context, _ *log.Logger, _ *analysis.State, _ string, _ []byte) (any, error) {
+		close(started)
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+
+	// the handler only returns once the request's context is cancelled
+	server.dispatch(hoverMessage("3"))
+	<-started
+	server.dispatch(frame(`{"jsonrpc":"2.0","method":"$/cancelRequest","params":{"id":3}}`))
+
+	response := writer.next(t)
+	if response["id"] != float64(3) || errorCode(response) != float64(-32800) {
+		t.Fatalf("expected a RequestCancelled error for request 3, got %v", response)
+	}
+}
+
+func TestServerOrdersNotifications(t *testing.T) {
+	server, writer := newTestServer(t, 4)
+
+	server.dispatch(frame(`{"jsonrpc":"2.0","method":"textDocument/didChange","params":{"textDocument":{"uri":"file:///models/a.sql"},"contentChanges":[{"text":"select 1"}]}}`))
+	server.dispatch(frame(`{"jsonrpc":"2.0","id":1,"method":"shutdown"}`))
+
+	// both are handled before dispatch returns, in order
+	expected := []string{
+		`{"jsonrpc":"2.0","method":"textDocument/publishDiagnostics","params":{"uri":"file:///models/a.sql","diagnostics":[]}}`,
+		`{"jsonrpc":"2.0","id":1,"result":null}`,
+	}
+	for _, e := range expected {
+		select {
+		case msg := <-writer:
+			if _, contents, _ := rpc.DecodeMessage(msg); string(contents) != e {
+				t.Errorf("expected %s, got %s", e, contents)
+			}
+		default:
+			t.Fatalf("expected %s to be written on the read loop", e)
+		}
+	}
+}
+
+func TestServerErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		message []byte
+		id      any
+		code    float64
+	}{
+		{"parse error", frame(`{"jsonrpc":"2.0","id":1,`), nil, -32700},
+		{"missing method", frame(`{"jsonrpc":"2.0","params":{}}`), nil, -32600},
+		{"object id", frame(`{"jsonrpc":"2.0","id":{"a":1},"method":"textDocument/hover"}`), nil, -32600},
+		{"string id", frame(`{"jsonrpc":"2.0","id":"a","method":"textDocument/hover","params":{"textDocument":{"uri":"file:///models/a.sql"},"position":{"line":0,"character":0}}}`), "a", 0},
+		{"unknown method", frame(`{"jsonrpc":"2.0","id":2,"method":"textDocument/unknown"}`), float64(2), -32601},
+		{"invalid params", frame(`{"jsonrpc":"2.0","id":3,"method":"textDocument/hover","params":{"position":"start"}}`), float64(3), -32602},
+		{"unknown command", frame(`{"jsonrpc":"2.0","id":4,"method":"workspace/executeCommand","params":{"command":"dbt.unknown"}}`), float64(4), -32602},
+		{"out of range completion", frame(`{"jsonrpc":"2.0","id":5,"method":"textDocument/completion","params":{"textDocument":{"uri":"file:///models/a.sql"},"position":{"line":9,"character":40}}}`), float64(5), 0},
+		{"unopened document", frame(`{"jsonrpc":"2.0","id":6,"method":"textDocument/hover","params":{"textDocument":{"uri":"file:///models/b.sql"},"position":{"line":0,"character":0}}}`), float64(6), 0},
+	}
+	server, writer := newTestServer(t, 1)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server.dispatch(tt.message)
+			response := writer.next(t)
+			if response["id"] != tt.id {
+				t.Errorf("expected id %v, got %v", tt.id, response)
+			}
+			if tt.code == 0 {
+				if response["error"] != nil {
+					t.Errorf("expected a result, got %v", response)
+				}
+				return
+			}
+			if errorCode(response) != tt.code {
+				t.Errorf("expected error code %v, got %v", tt.code, response)
+			}
+		})
+	}
+
+	// notifications never get a response, even for unknown methods
+	server.dispatch(frame(`{"jsonrpc":"2.0","method":"$/setTrace","params":{"value":"off"}}`))
+	writer.expectNone(t)
+}
+
+func TestServerNotInitialized(t *testing.T) {
+	state := analysis.NewState()
+	writer := make(messageWriter, 10)
+	server := newServer(log.New(io.Discard, "", 0), writer, &state, 1)
+
+	server.dispatch(hoverMessage("1"))
+	if response := writer.next(t); response["id"] != float64(1) || errorCode(response) != float64(-32002) {
+		t.Errorf("expected ServerNotInitialized, got %v", response)
+	}
+
+	server.dispatch(frame(`{"jsonrpc":"2.0","method":"textDocument/didChange","params":{"textDocument":{"uri":"file:///models/a.sql"},"contentChanges":[]}}`))
+	writer.expectNone(t)
+}
+
+func TestServerRecoversPanics(t *testing.T) {
+	server, writer := newTestServer(t, 1)
+	server.handle = func(_ context.Context, _ *log.Logger, _ *analysis.State, method string, _ []byte) (any, error) {
+		var documents map[string]int
+		documents[method]++
+		return nil, nil
+	}
+
+	server.dispatch(hoverMessage("1"))
+	if response := writer.next(t); response["id"] != float64(1) || errorCode(response) != float64(-32603) {
+		t.Errorf("expected InternalError, got %v", response)
+	}
+
+	server.handle = handleRequest
+	server.dispatch(hoverMessage("2"))
+	if response := writer.next(t); response["id"] != float64(2) || response["error"] != nil {
+		t.Errorf("expected the server to keep handling requests, got %v", response)
+	}
+}
+
+func TestServerLifecycle(t *testing.T) {
+	server, writer := newTestServer(t, 1)
+	server.dispatch(frame(`{"jsonrpc":"2.0","method":"initialized","params":{}}`))
+	writer.expectNone(t)
+
+	server.dispatch(frame(`{"jsonrpc":"2.0","id":1,"method":"initialize","params":{}}`))
+	if response := writer.next(t); errorCode(response) != float64(-32600) {
+		t.Errorf("expected a second initialize to be refused, got %v", response)
+	}
+
+	server.dispatch(frame(`{"jsonrpc":"2.0","method":"textDocument/didClose","params":{"textDocument":{"uri":"file:///models/a.sql"}}}`))
+	if _, open := server.state.Documents["file:///models/a.sql"]; open {
+		t.Error("expected the closed document to be dropped")
+	}
+	response := writer.next(t)
+	params, _ := response["params"].(map[string]any)
+	if response["method"] != "textDocument/publishDiagnostics" || params["uri"] != "file:///models/a.sql" || len(params["diagnostics"].([]any)) != 0 {
+		t.Errorf("expected the closed document's diagnostics to be cleared, got %v", response)
+	}
+
+	if code := exitCode(server.state); code != 1 {
+		t.Errorf("expected exit code 1 before shutdown, got %d", code)
+	}
+
+	server.dispatch(frame(`{"jsonrpc":"2.0","id":2,"method":"shutdown"}`))
+	if response := writer.next(t); response["id"] != float64(2) || response["error"] != nil {
+		t.Fatalf("expected a shutdown result, got %v", response)
+	}
+
+	server.dispatch(hoverMessage("3"))
+	if response := writer.next(t); response["id"] != float64(3) || errorCode(response) != float64(-32600) {
+		t.Errorf("expected requests after shutdown to be refused, got %v", response)
+	}
+	server.dispatch(frame(`{"jsonrpc":"2.0","method":"textDocument/didChange","params":{"textDocument":{"uri":"file:///models/a.sql"},"contentChanges":[]}}`))
+	writer.expectNone(t)
+
+	if code := exitCode(server.state); code != 0 {
+		t.Errorf("expected exit code 0 after shutdown, got %d", code)
+	}
+}
+
+func TestServerNegotiatesPositionEncoding(t *testing.T) {
+	state := analysis.NewState()
+	writer := make(messageWriter, 10)
+	server := newServer(log.New(io.Discard, "", 0), writer, &state, 1)
+
+	server.dispatch(frame(`{"jsonrpc":"2.0","id":0,"method":"initialize","params":{"capabilities":{"general":{"positionEncodings":["utf-32","utf-16"]}}}}`))
+	result, _ := writer.next(t)["result"].(map[string]any)
+	capabilities, _ := result["capabilities"].(map[string]any)
+	if capabilities["positionEncoding"] != "utf-32" || state.PositionEncoding() != analysis.UTF32 {
+		t.Errorf("expected utf-32 to be negotiated, got %v", capabilities["positionEncoding"])
+	}
+}
+
+func TestServerWorkspaceFolders(t *testing.T) {
+	state := analysis.NewState()
+	writer := make(messageWriter, 10)
+	server := newServer(log.New(io.Discard, "", 0), writer, &state, 1)
+
+	server.dispatch(frame(`{"jsonrpc":"2.0","id":0,"method":"initialize","params":{"rootPath":"/old","rootUri":"file:///repo","workspaceFolders":[{"uri":"file:///repo/a","name":"a"},{"uri":"file:///repo/b","name":"b"}]}}`))
+	writer.next(t)
+	if state.LspClientRootPath != "/repo" {
+		t.Errorf("expected the root path from rootUri, got %q", state.LspClientRootPath)
+	}
+	if expected := []string{"/repo/a", "/repo/b"}; !reflect.DeepEqual(state.WorkspaceFolders, expected) {
+		t.Errorf("expected %v, got %v", expected, state.WorkspaceFolders)
+	}
+
+	server.dispatch(frame(`{"jsonrpc":"2.0","method":"workspace/didChangeWorkspaceFolders","params":{"event":{"added":[{"uri":"file:///repo/c","name":"c"}],"removed":[{"uri":"file:///repo/a","name":"a"}]}}}`))
+	if expected := []string{"/repo/b", "/repo/c"}; !reflect.DeepEqual(state.WorkspaceFolders, expected) {
+		t.Errorf("expected %v, got %v", expected, state.WorkspaceFolders)
+	}
+}
+
+func TestWorkspaceFoldersFallBackToRootPath(t *testing.T) {
+	rootPath, folders := workspaceFolders(lsp.InitializeRequestParams{RootPath: "/repo"})
+	if rootPath != "/repo" || !reflect.DeepEqual(folders, []string{"/repo"}) {
+		t.Errorf("expected /repo as the only folder, got %q %v", rootPath, folders)
+	}
+}
@@ -0,0 +1 @@
+select 2
\ No newline at end of file
@@ -0,0 +1,2 @@
+{{ config(group='finance') }}
+select * from {{ ref('revenue') }}
\ No newline at end of file
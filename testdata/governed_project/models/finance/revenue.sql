@@ -0,0 +1,2 @@
+{{ config(access='private', group='finance') }}
+select 1
\ No newline at end of file
@@ -0,0 +1,2 @@
+{{ config(access='public') }}
+select 1
\ No newline at end of file
@@ -0,0 +1,7 @@
+{% test not_empty(model, column_name) %}
+
+select *
+from {{ model }}
+where {{ column_name }} = ''
+
+{% endtest %}
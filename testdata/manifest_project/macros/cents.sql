@@ -0,0 +1,3 @@
+{% macro cents(col, scale=2) %}
+    ({{ col }} * 100)
+{% endmacro %}
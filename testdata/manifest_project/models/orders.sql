@@ -0,0 +1 @@
+select 1 as order_id from {{ ref('customers') }}
\ No newline at end of file
@@ -0,0 +1 @@
+select * from {{ ref('orders') }}
\ No newline at end of file
@@ -0,0 +1 @@
+select * from {{ ref('orders') }} where order_id < 0
\ No newline at end of file
@@ -0,0 +1 @@
+select 'package' as package_order_id
\ No newline at end of file
@@ -0,0 +1 @@
+select 3 as customer_uuid
\ No newline at end of file
@@ -0,0 +1 @@
+select 1 as customer_id from {{ ref('orders') }}
\ No newline at end of file
@@ -0,0 +1 @@
+select 2 as customer_key
\ No newline at end of file
@@ -1,8 +1,10 @@
 package testutils
 
 import (
+	"os"
 	"path/filepath"
 	"runtime"
+	"testing"
 )
 
 // GetTestDataPath returns the absolute path to a file in the testdata directory
@@ -17,6 +19,22 @@ func GetTestdataPath(relativePath string) (string, error) {
 	return filepath.Join(basePath, relativePath), nil
 }
 
+// CopyTestdata copies a directory in the testdata directory to a temporary
+// directory, for tests that modify the files, and returns its path
+func CopyTestdata(t *testing.T, relativePath string) string {
+	t.Helper()
+
+	path, err := GetTestdataPath(relativePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	root := t.TempDir()
+	if err := os.CopyFS(root, os.DirFS(path)); err != nil {
+		t.Fatal(err)
+	}
+	return root
+}
+
 type PathError struct {
 	Message string
 }
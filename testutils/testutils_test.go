@@ -1,6 +1,8 @@
 package testutils
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 )
 
@@ -14,3 +16,11 @@ func TestGetTestdataPath(t *testing.T) {
 		t.Fatal("expected a valid path, got an empty string")
 	}
 }
+
+func TestCopyTestdata(t *testing.T) {
+	root := CopyTestdata(t, "manifest_project")
+
+	if _, err := os.Stat(filepath.Join(root, "dbt_project.yml")); err != nil {
+		t.Fatalf("expected the project to be copied: %v", err)
+	}
+}
@@ -3,6 +3,7 @@ package util
 import (
 	"os"
 	"path/filepath"
+	"strings"
 )
 
 func ReadFileContents(uri string) (string, error) {
@@ -28,6 +29,28 @@ func GetLineAndColumn(input string, idx int) (line, column int) {
 	return line, column
 }
 
+// GetOffset is the inverse of GetLineAndColumn. Positions past the end of a
+// line are clamped to the line end.
+func GetOffset(input string, line int, column int) int {
+	offset := 0
+	for l := 0; l < line; l++ {
+		next := strings.IndexByte(input[offset:], '\n')
+		if next == -1 {
+			return len(input)
+		}
+		offset += next + 1
+	}
+
+	lineEnd := strings.IndexByte(input[offset:], '\n')
+	if lineEnd == -1 {
+		lineEnd = len(input) - offset
+	}
+	if column > lineEnd {
+		column = lineEnd
+	}
+	return offset + column
+}
+
 func CreateFileNameMap(fileExtensions []string, root string, paths []string) (map[string]string, error) {
 	fileMap := make(map[string]string)
 
@@ -0,0 +1,34 @@
+package util
+
+import "testing"
+
+func TestGetOffset(t *testing.T) {
+	input := "select\n    id\nfrom t"
+
+	testCases := []struct {
+		name     string
+		line     int
+		column   int
+		expected int
+	}{
+		{name: "start", line: 0, column: 0, expected: 0},
+		{name: "second line", line: 1, column: 4, expected: 11},
+		{name: "past line end", line: 1, column: 40, expected: 13},
+		{name: "past last line", line: 5, column: 0, expected: len(input)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			offset := GetOffset(input, tc.line, tc.column)
+			if offset != tc.expected {
+				t.Errorf("got %d, want %d", offset, tc.expected)
+			}
+			if tc.line < 2 {
+				line, column := GetLineAndColumn(input, offset)
+				if line != tc.line || (tc.column <= 4 && column != tc.column) {
+					t.Errorf("round trip got %d:%d", line, column)
+				}
+			}
+		})
+	}
+}
@@ -8,6 +8,10 @@ import (
 func WalkFilepath(path string, fileExt string) ([]string, error) {
 	validPaths := []string{}
 	err := filepath.Walk(path, func(path string, info os.FileInfo, err error) error {
+		// a missing directory, e.g. a configured path that doesn't exist
+		if err != nil {
+			return nil
+		}
 		if !info.IsDir() {
 			if filepath.Ext(path) == fileExt {
 				validPaths = append(validPaths, path)